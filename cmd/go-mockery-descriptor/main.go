@@ -20,9 +20,9 @@ func initConfig() *config.Config {
 	return cfg
 }
 
-func generateFileName(cfg *config.Config, interfaceName string) (string, error) {
+func generateFileName(fileNameTemplate, interfaceName string) (string, error) {
 	tmpl := template.New("fileName.tmpl")
-	tmpl, err := tmpl.Parse(cfg.Output)
+	tmpl, err := tmpl.Parse(fileNameTemplate)
 	if err != nil {
 		return "", err
 	}
@@ -35,15 +35,32 @@ func generateFileName(cfg *config.Config, interfaceName string) (string, error)
 	return buf.String(), nil
 }
 
+func writeSchema(a *app.App, ifaceCfg *config.InterfaceConfig) {
+	schema, err := a.RunSchema(ifaceCfg)
+	if err != nil {
+		log.Fatalf("Failed to generate schema: %v", err)
+	}
+
+	fileName, err := generateFileName(ifaceCfg.SchemaOutput, ifaceCfg.Name)
+	if err != nil {
+		log.Fatalf("Failed to generate schema: %v", err)
+	}
+
+	if err = os.WriteFile(fileName, []byte(schema), 0o600); err != nil { //nolint:mnd
+		log.Fatalf("Failed to write schema file: %v", err)
+	}
+}
+
 func main() {
 	cfg := initConfig()
+	a := app.New()
 	for _, ifaceCfg := range cfg.Interfaces {
-		output, err := app.Run(&ifaceCfg)
+		output, err := a.Run(&ifaceCfg)
 		if err != nil {
 			log.Fatalf("Failed to generate code: %v", err)
 		}
 
-		fileName, err := generateFileName(cfg, ifaceCfg.Name)
+		fileName, err := generateFileName(cfg.Output, ifaceCfg.Name)
 		if err != nil {
 			log.Fatalf("Failed to generate code: %v", err)
 		}
@@ -51,5 +68,9 @@ func main() {
 		if err = os.WriteFile(fileName, []byte(output), 0o600); err != nil { //nolint:mnd
 			log.Fatalf("Failed to write output file: %v", err)
 		}
+
+		if ifaceCfg.Fixtures {
+			writeSchema(a, &ifaceCfg)
+		}
 	}
 }
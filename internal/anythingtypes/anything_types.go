@@ -0,0 +1,158 @@
+package anythingtypes
+
+import (
+	"errors"
+	"go/types"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/xgamtx/go-mockery-descriptor/internal/parser"
+)
+
+// Defaults are used when the config does not list anything types.
+var Defaults = []string{ //nolint:gochecknoglobals
+	"context.Context=anyCtx",
+	"github.com/jackc/pgx/v5.Tx=anyTx",
+	"github.com/jackc/pgx/v4.Tx=anyTx",
+}
+
+var errInvalidAnythingType = errors.New("invalid anything type")
+
+const (
+	anythingPrefix     = "any"
+	sameInstancePrefix = "same"
+)
+
+type anythingType struct {
+	pointers     int
+	path         string
+	name         string
+	varName      string
+	sameInstance bool
+	t            types.Type
+}
+
+// newAnythingType parses params written as path.Type with optional leading stars and an optional =varName suffix,
+// the name of the variable defaults to the prefix followed by the name of the type.
+func newAnythingType(params, prefix string) (*anythingType, error) {
+	paramsParser := regexp.MustCompile(`^(\**)(.+)\.([a-zA-Z_][a-zA-Z0-9_]*)(=([a-zA-Z_][a-zA-Z0-9_]*))?$`)
+	match := paramsParser.FindStringSubmatch(params)
+	if len(match) == 0 {
+		return nil, errInvalidAnythingType
+	}
+
+	varName := match[5]
+	if varName == "" {
+		varName = prefix + strings.ToUpper(match[3][:1]) + match[3][1:]
+	}
+
+	return &anythingType{pointers: len(match[1]), path: match[2], name: match[3], varName: varName}, nil
+}
+
+// resolve finds the type among packages imported by pkg directly or indirectly, types of packages
+// not imported by pkg can't be used in its interfaces and stay unresolved.
+func (a *anythingType) resolve(pkg *types.Package) {
+	found := parser.FindPackage(pkg, a.path)
+	if found == nil {
+		return
+	}
+
+	obj, ok := found.Scope().Lookup(a.name).(*types.TypeName)
+	if !ok {
+		return
+	}
+
+	a.t = obj.Type()
+	for range a.pointers {
+		a.t = types.NewPointer(a.t)
+	}
+}
+
+// matches reports whether t is the type itself or implements it when the type is an interface.
+func (a *anythingType) matches(t types.Type) bool {
+	if a.t == nil || t == nil {
+		return false
+	}
+	if types.Identical(t, a.t) {
+		return true
+	}
+
+	iface, ok := a.t.Underlying().(*types.Interface)
+
+	return ok && !iface.Empty() && types.Implements(t, iface)
+}
+
+// Storage keeps types of params matched by anything or by the same instance in all calls, such params get
+// no descriptor fields.
+type Storage struct {
+	types []anythingType
+}
+
+// NewStorage parses params and resolves them in pkg, nil params stand for Defaults. Same instance types go first,
+// so they win over anything types.
+func NewStorage(params, sameInstanceParams []string, pkg *types.Package) (*Storage, error) {
+	if params == nil {
+		params = Defaults
+	}
+
+	res := make([]anythingType, 0, len(sameInstanceParams)+len(params))
+	for _, param := range sameInstanceParams {
+		a, err := newAnythingType(param, sameInstancePrefix)
+		if err != nil {
+			return nil, err
+		}
+
+		a.sameInstance = true
+		a.resolve(pkg)
+		res = append(res, *a)
+	}
+	for _, param := range params {
+		a, err := newAnythingType(param, anythingPrefix)
+		if err != nil {
+			return nil, err
+		}
+
+		a.resolve(pkg)
+		res = append(res, *a)
+	}
+
+	return &Storage{types: res}, nil
+}
+
+// Get returns the name of the variable matching params of type t, it is empty for other types.
+func (s *Storage) Get(t types.Type) string {
+	if s == nil {
+		return ""
+	}
+
+	for _, a := range s.types {
+		if a.matches(t) {
+			return a.varName
+		}
+	}
+
+	return ""
+}
+
+// Var is a variable matching params of its types, SameInstance variables match the instance of the first call only.
+type Var struct {
+	Name         string
+	SameInstance bool
+}
+
+// Vars returns variables in the order of params without duplicates.
+func (s *Storage) Vars() []Var {
+	if s == nil {
+		return nil
+	}
+
+	res := make([]Var, 0, len(s.types))
+	for _, a := range s.types {
+		if !slices.ContainsFunc(res, func(v Var) bool { return v.Name == a.varName }) {
+			res = append(res, Var{Name: a.varName, SameInstance: a.sameInstance})
+		}
+	}
+
+	return res
+}
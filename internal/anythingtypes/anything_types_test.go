@@ -0,0 +1,116 @@
+package anythingtypes //nolint:testpackage
+
+import (
+	"go/types"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_newAnythingType(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+
+		params string
+		prefix string
+
+		want       *anythingType
+		wantErrMsg string
+	}{
+		{
+			name:   "OK, with variable name",
+			params: "context.Context=anyCtx",
+			prefix: anythingPrefix,
+
+			want: &anythingType{path: "context", name: "Context", varName: "anyCtx"},
+		},
+		{
+			name:   "OK, pointer with derived variable name",
+			params: "*database/sql.Tx",
+			prefix: anythingPrefix,
+
+			want: &anythingType{pointers: 1, path: "database/sql", name: "Tx", varName: "anyTx"},
+		},
+		{
+			name:   "OK, versioned path",
+			params: "github.com/jackc/pgx/v5.Tx=anyTx",
+			prefix: anythingPrefix,
+
+			want: &anythingType{path: "github.com/jackc/pgx/v5", name: "Tx", varName: "anyTx"},
+		},
+		{
+			name:   "OK, same instance variable name",
+			params: "github.com/jackc/pgx/v5.Tx",
+			prefix: sameInstancePrefix,
+
+			want: &anythingType{path: "github.com/jackc/pgx/v5", name: "Tx", varName: "sameTx"},
+		},
+		{
+			name:   "invalid type",
+			params: "Context",
+			prefix: anythingPrefix,
+
+			wantErrMsg: errInvalidAnythingType.Error(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := newAnythingType(tt.params, tt.prefix)
+			if tt.wantErrMsg != "" {
+				require.EqualError(t, err, tt.wantErrMsg)
+
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestStorage_Get(t *testing.T) {
+	t.Parallel()
+
+	logPkg := types.NewPackage("example.com/log", "log")
+	logSig := types.NewSignatureType(nil, nil, nil, types.NewTuple(types.NewVar(0, logPkg, "msg", types.Typ[types.String])), nil, false)
+	loggerIface := types.NewInterfaceType([]*types.Func{types.NewFunc(0, logPkg, "Log", logSig)}, nil).Complete()
+	logger := types.NewNamed(types.NewTypeName(0, logPkg, "Logger", nil), loggerIface, nil)
+	logPkg.Scope().Insert(logger.Obj())
+
+	file := types.NewNamed(types.NewTypeName(0, logPkg, "File", nil), types.NewStruct(nil, nil), nil)
+	file.AddMethod(types.NewFunc(0, logPkg, "Log", types.NewSignatureType(
+		types.NewVar(0, logPkg, "f", types.NewPointer(file)), nil, nil,
+		types.NewTuple(types.NewVar(0, logPkg, "msg", types.Typ[types.String])), nil, false,
+	)))
+	logPkg.Scope().Insert(file.Obj())
+
+	conn := types.NewNamed(types.NewTypeName(0, logPkg, "Conn", nil), types.NewStruct(nil, nil), nil)
+	logPkg.Scope().Insert(conn.Obj())
+
+	pkg := types.NewPackage("example.com/app", "app")
+	pkg.SetImports([]*types.Package{logPkg})
+
+	storage, err := NewStorage(
+		[]string{"example.com/log.Logger", "*example.com/log.File=anyFile", "unknown.Type", "*example.com/log.Conn"},
+		[]string{"*example.com/log.Conn"},
+		pkg,
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, "anyLogger", storage.Get(logger))
+	assert.Equal(t, "anyLogger", storage.Get(types.NewPointer(file)), "implementation of the interface")
+	assert.Empty(t, storage.Get(file), "value type does not implement the interface")
+	assert.Empty(t, storage.Get(types.Typ[types.String]))
+	assert.Equal(t, "sameConn", storage.Get(types.NewPointer(conn)), "same instance types win")
+	assert.Equal(t, []Var{
+		{Name: "sameConn", SameInstance: true},
+		{Name: "anyLogger"},
+		{Name: "anyFile"},
+		{Name: "anyType"},
+		{Name: "anyConn"},
+	}, storage.Vars())
+}
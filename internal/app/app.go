@@ -1,20 +1,66 @@
 package app
 
 import (
+	"github.com/xgamtx/go-mockery-descriptor/internal/anythingtypes"
 	"github.com/xgamtx/go-mockery-descriptor/internal/config"
 	"github.com/xgamtx/go-mockery-descriptor/internal/fieldoverwriter"
 	"github.com/xgamtx/go-mockery-descriptor/internal/generator"
+	"github.com/xgamtx/go-mockery-descriptor/internal/outparams"
 	"github.com/xgamtx/go-mockery-descriptor/internal/parser"
 	"github.com/xgamtx/go-mockery-descriptor/internal/returnsrenamer"
 )
 
-func Run(cfg *config.InterfaceConfig) (string, error) {
-	desc, err := parser.ParseInterfaceInDir(cfg.Dir, cfg.Name)
+type generateFunc func(
+	*config.InterfaceConfig, *parser.Interface, *fieldoverwriter.Storage, *returnsrenamer.Storage, *outparams.Storage,
+	*anythingtypes.Storage,
+) (string, error)
+
+// App generates code of interfaces, packages are loaded once per App.
+type App struct {
+	loader *parser.Loader
+}
+
+func New() *App {
+	return &App{loader: parser.NewLoader()}
+}
+
+func (a *App) Run(cfg *config.InterfaceConfig) (string, error) {
+	return a.run(cfg, generator.Generate)
+}
+
+// RunSchema returns JSON Schema of fixtures with descriptors of the interface.
+func (a *App) RunSchema(cfg *config.InterfaceConfig) (string, error) {
+	return a.run(cfg, generator.GenerateSchema)
+}
+
+func (a *App) run(cfg *config.InterfaceConfig, generate generateFunc) (string, error) {
+	desc, err := a.loader.ParseInterfaceInDir(cfg.Dir, cfg.Name)
 	if err != nil {
 		return "", err
 	}
 
-	overwriterStorage, err := fieldoverwriter.NewStorage(cfg.FieldOverwriterParams)
+	aliases := make(map[string]fieldoverwriter.Alias, len(cfg.MatcherAliases))
+	for name, alias := range cfg.MatcherAliases {
+		aliases[name] = fieldoverwriter.Alias{Path: alias.Path, Func: alias.Func, Shape: alias.Shape}
+	}
+
+	rules := make([]fieldoverwriter.Rule, 0, len(cfg.FieldOverwriterRules)+1)
+	for _, rule := range cfg.FieldOverwriterRules {
+		rules = append(rules, fieldoverwriter.Rule{
+			Method:       rule.Method,
+			MethodRegexp: rule.MethodRegexp,
+			Param:        rule.Param,
+			Type:         rule.Type,
+			Context:      rule.Context,
+			Matcher:      rule.Matcher,
+		})
+	}
+	if cfg.IsErrorIs() {
+		// a fallback, so any param or rule of the config selecting an error param wins
+		rules = append(rules, fieldoverwriter.Rule{Type: "error", Matcher: fieldoverwriter.StdFuncErrorIs, Fallback: true})
+	}
+
+	overwriterStorage, err := fieldoverwriter.NewStorage(cfg.FieldOverwriterParams, rules, aliases, desc.Package)
 	if err != nil {
 		return "", err
 	}
@@ -24,5 +70,15 @@ func Run(cfg *config.InterfaceConfig) (string, error) {
 		return "", err
 	}
 
-	return generator.Generate(cfg, desc, overwriterStorage, returnRenamerStorage)
+	outParamsStorage, err := outparams.NewStorage(cfg.OutParams)
+	if err != nil {
+		return "", err
+	}
+
+	anythingTypesStorage, err := anythingtypes.NewStorage(cfg.AnythingTypes, cfg.SameInstance, desc.Package)
+	if err != nil {
+		return "", err
+	}
+
+	return generate(cfg, desc, overwriterStorage, returnRenamerStorage, outParamsStorage, anythingTypesStorage)
 }
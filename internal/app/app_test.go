@@ -2,9 +2,13 @@ package app_test
 
 import (
 	_ "embed"
+	"encoding/json"
+	"net/url"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/xgamtx/go-mockery-descriptor/internal/app"
 	"github.com/xgamtx/go-mockery-descriptor/internal/config"
@@ -13,10 +17,103 @@ import (
 //go:embed some.gen_test.go
 var expectedRes string
 
+//go:embed testdata/spy.golden
+var expectedSpyRes string
+
+//go:embed testdata/recorder.golden
+var expectedRecorderRes string
+
+//go:embed testdata/fixtures.golden
+var expectedFixturesRes string
+
+//go:embed compiled/workflow.gen_test.go
+var expectedOrderedRes string
+
+//go:embed compiled/poller.gen_test.go
+var expectedRepetitionRes string
+
+//go:embed compiled/pipeline.gen_test.go
+var expectedHooksRes string
+
+//go:embed compiled/scanner.gen_test.go
+var expectedOutParamsRes string
+
+//go:embed compiled/dispatcher.gen_test.go
+var expectedVariadicRes string
+
+//go:embed compiled/worker.gen_test.go
+var expectedFailuresRes string
+
+//go:embed compiled/index.gen_test.go
+var expectedMatchersRes string
+
+//go:embed testdata/shapes.golden
+var expectedShapesRes string
+
+//go:embed testdata/matcher_args.golden
+var expectedMatcherArgsRes string
+
+//go:embed testdata/rules.golden
+var expectedRulesRes string
+
+//go:embed testdata/anything_types.golden
+var expectedAnythingTypesRes string
+
+//go:embed testdata/context.golden
+var expectedContextRes string
+
+//go:embed compiled/repo.gen_test.go
+var expectedSameInstanceRes string
+
+//go:embed testdata/fields.golden
+var expectedFieldsRes string
+
+//go:embed testdata/equality.golden
+var expectedEqualityRes string
+
+//go:embed testdata/tolerance.golden
+var expectedToleranceRes string
+
+//go:embed testdata/capture.golden
+var expectedCaptureRes string
+
+//go:embed testdata/errors.golden
+var expectedErrorsRes string
+
+//go:embed compiled/events.gen_test.go
+var expectedTextRes string
+
+//go:embed compiled/ledger.gen_test.go
+var expectedBetweenRes string
+
+//go:embed testdata/some.calls.schema.json
+var expectedSchemaRes string
+
+//go:embed testdata/scanner.calls.schema.json
+var expectedScannerSchemaRes string
+
+func someConfig(modify func(cfg *config.InterfaceConfig)) *config.InterfaceConfig {
+	cfg := &config.InterfaceConfig{
+		Name:                  "Some",
+		ConstructorName:       "newMock{{ . }}",
+		PackageName:           "{{ . }}",
+		FieldOverwriterParams: []string{"Slice.rows=elementsMatch", "SetX.x=oneOf", "Anything.v=any"},
+		RenameReturns: map[string]string{
+			"GetX.r0":  "X",
+			"Multi.r0": "X",
+			"Multi.r1": "Y",
+		},
+	}
+	modify(cfg)
+
+	return cfg
+}
+
 //go:generate mockery --name=Some --inpackage --with-expecter=true --structname=mockSome
 func TestRun(t *testing.T) {
 	t.Parallel()
 
+	a := app.New()
 	tests := []struct {
 		name string
 
@@ -42,18 +139,414 @@ func TestRun(t *testing.T) {
 
 			want: expectedRes,
 		},
+		{
+			name: "success, spy",
+
+			cfg: someConfig(func(cfg *config.InterfaceConfig) { cfg.Spy = true }),
+
+			want: expectedSpyRes,
+		},
+		{
+			name: "success, recorder",
+
+			cfg: someConfig(func(cfg *config.InterfaceConfig) { cfg.Recorder = true }),
+
+			want: expectedRecorderRes,
+		},
+		{
+			name: "success, fixtures",
+
+			cfg: someConfig(func(cfg *config.InterfaceConfig) { cfg.Fixtures = true }),
+
+			want: expectedFixturesRes,
+		},
+		{
+			name: "success, ordered",
+
+			cfg: someConfig(func(cfg *config.InterfaceConfig) {
+				cfg.Name = "Workflow"
+				cfg.Dir = "./compiled"
+				cfg.FieldOverwriterParams = []string{"Batch.rows=elementsMatch"}
+				cfg.Ordered = true
+			}),
+
+			want: expectedOrderedRes,
+		},
+		{
+			name: "success, repetition",
+
+			cfg: someConfig(func(cfg *config.InterfaceConfig) {
+				cfg.Name = "Poller"
+				cfg.Dir = "./compiled"
+				cfg.FieldOverwriterParams = nil
+				cfg.CallFields = config.CallFieldsConfig{Times: "Times", Maybe: "Maybe"}
+			}),
+
+			want: expectedRepetitionRes,
+		},
+		{
+			name: "success, hooks",
+
+			cfg: someConfig(func(cfg *config.InterfaceConfig) {
+				cfg.Name = "Pipeline"
+				cfg.Dir = "./compiled"
+				cfg.FieldOverwriterParams = nil
+				cfg.CallFields = config.CallFieldsConfig{Run: "Run", Do: "Do"}
+			}),
+
+			want: expectedHooksRes,
+		},
+		{
+			name: "success, out params",
+
+			cfg: someConfig(func(cfg *config.InterfaceConfig) {
+				cfg.Name = "Scanner"
+				cfg.Dir = "./compiled"
+				cfg.FieldOverwriterParams = nil
+				cfg.OutParams = []string{"Scan.dest", "Decode.v"}
+				cfg.Spy = true
+			}),
+
+			want: expectedOutParamsRes,
+		},
+		{
+			name: "success, variadic and channel params",
+
+			cfg: someConfig(func(cfg *config.InterfaceConfig) {
+				cfg.Name = "Dispatcher"
+				cfg.Dir = "./compiled"
+				cfg.FieldOverwriterParams = nil
+				cfg.Spy = true
+			}),
+
+			want: expectedVariadicRes,
+		},
+		{
+			name: "success, failures",
+
+			cfg: someConfig(func(cfg *config.InterfaceConfig) {
+				cfg.Name = "Worker"
+				cfg.Dir = "./compiled"
+				cfg.FieldOverwriterParams = nil
+				cfg.CallFields = config.CallFieldsConfig{
+					Panic: "Panic", Delay: "Delay", BlockUntilCtxDone: "BlockUntilCtxDone",
+				}
+			}),
+
+			want: expectedFailuresRes,
+		},
+		{
+			name: "success, matcher overrides",
+
+			cfg: someConfig(func(cfg *config.InterfaceConfig) {
+				cfg.Name = "Index"
+				cfg.Dir = "./compiled"
+				cfg.FieldOverwriterParams = []string{"Store.rows=elementsMatch"}
+				cfg.CallFields = config.CallFieldsConfig{MatcherSuffix: "Matcher"}
+			}),
+
+			want: expectedMatchersRes,
+		},
+		{
+			name: "success, field shapes",
+
+			cfg: someConfig(func(cfg *config.InterfaceConfig) {
+				cfg.FieldOverwriterParams = append(cfg.FieldOverwriterParams,
+					"M.m=github.com/stretchr/testify/mock.MatchedBy:predicate", "GetX.0=any:none")
+			}),
+
+			want: expectedShapesRes,
+		},
+		{
+			name: "success, matcher aliases",
+
+			cfg: someConfig(func(cfg *config.InterfaceConfig) {
+				cfg.MatcherAliases = map[string]config.MatcherAlias{
+					"matchedby": {Path: "github.com/stretchr/testify/mock", Func: "MatchedBy", Shape: "predicate"},
+				}
+				cfg.FieldOverwriterParams = append(cfg.FieldOverwriterParams, "M.m=matchedBy", "GetX.0=any:none")
+			}),
+
+			want: expectedShapesRes,
+		},
+		{
+			name: "success, matcher arguments",
+
+			cfg: someConfig(func(cfg *config.InterfaceConfig) {
+				cfg.FieldOverwriterParams = []string{
+					"Slice.rows=assessor.ElementsMatch",
+					"Anything.v=github.com/acme/testutil.InRange(1, 10):none",
+					`M.m=github.com/acme/testutil.HasKeys("a", "b")`,
+					"GetX.ctx=github.com/acme/testutil.NotEmpty():none",
+				}
+			}),
+
+			want: expectedMatcherArgsRes,
+		},
+		{
+			name: "success, field overwriter rules",
+
+			cfg: someConfig(func(cfg *config.InterfaceConfig) {
+				cfg.FieldOverwriterParams = []string{"Anything.v=any"}
+				cfg.FieldOverwriterRules = []config.FieldOverwriterRule{
+					{Type: "[]string", Matcher: "elementsMatch"},
+					{Method: "M*", Param: "*", Matcher: "oneOf"},
+					{Method: "Anything", Param: "v", Matcher: "oneOf"},
+				}
+			}),
+
+			want: expectedRulesRes,
+		},
+		{
+			name: "success, anything types",
+
+			cfg: someConfig(func(cfg *config.InterfaceConfig) {
+				cfg.Name = "Repo"
+				cfg.FieldOverwriterParams = nil
+				cfg.AnythingTypes = []string{"context.Context=anyCtx", "*database/sql.Tx"}
+			}),
+
+			want: expectedAnythingTypesRes,
+		},
+		{
+			name: "success, context matchers",
+
+			cfg: someConfig(func(cfg *config.InterfaceConfig) {
+				cfg.Name = "Repo"
+				cfg.FieldOverwriterParams = nil
+				cfg.FieldOverwriterRules = []config.FieldOverwriterRule{{Context: true, Matcher: "contextNotCancelled"}}
+				cfg.CallFields.Ctx = "Ctx"
+			}),
+
+			want: expectedContextRes,
+		},
+		{
+			name: "success, same instance",
+
+			cfg: someConfig(func(cfg *config.InterfaceConfig) {
+				cfg.Name = "Repo"
+				cfg.FieldOverwriterParams = nil
+				cfg.Dir = "./compiled"
+				cfg.SameInstance = []string{"*database/sql.Tx"}
+			}),
+
+			want: expectedSameInstanceRes,
+		},
+		{
+			name: "success, partial struct matching",
+
+			cfg: someConfig(func(cfg *config.InterfaceConfig) {
+				cfg.Name = "Repo"
+				cfg.FieldOverwriterParams = []string{`Save.row=ignoreFields("ID")`}
+			}),
+
+			want: expectedFieldsRes,
+		},
+		{
+			name: "success, equality options",
+
+			cfg: someConfig(func(cfg *config.InterfaceConfig) {
+				cfg.Equality = &config.EqualityConfig{UseEqualMethods: true, FloatTolerance: 1e-9}
+			}),
+
+			want: expectedEqualityRes,
+		},
+		{
+			name: "success, tolerance matchers",
+
+			cfg: someConfig(func(cfg *config.InterfaceConfig) {
+				cfg.Name = "Ledger"
+				cfg.FieldOverwriterParams = []string{
+					"Charge.amount=inDelta(0.01)",
+					"Charge.at=withinDuration(5*time.Second)",
+					"Charge.attempt=between(1, 3)",
+				}
+			}),
+
+			want: expectedToleranceRes,
+		},
+		{
+			name: "success, captured arguments",
+
+			cfg: someConfig(func(cfg *config.InterfaceConfig) {
+				cfg.Name = "Repo"
+				cfg.FieldOverwriterParams = nil
+				cfg.CallFields.CapturePrefix = "Captured"
+			}),
+
+			want: expectedCaptureRes,
+		},
+		{
+			name: "success, error params matched by errors.Is",
+
+			cfg: someConfig(func(cfg *config.InterfaceConfig) {
+				cfg.Name = "Jobs"
+				cfg.FieldOverwriterParams = nil
+				errorIs := true
+				cfg.ErrorIs = &errorIs
+			}),
+
+			want: expectedErrorsRes,
+		},
+		{
+			name: "success, text matchers",
+
+			cfg: someConfig(func(cfg *config.InterfaceConfig) {
+				cfg.Name = "Events"
+				cfg.Dir = "./compiled"
+				cfg.FieldOverwriterParams = []string{"Publish.payload=jsonEq"}
+			}),
+
+			want: expectedTextRes,
+		},
+		{
+			name: "success, between of float params",
+
+			cfg: someConfig(func(cfg *config.InterfaceConfig) {
+				cfg.Name = "Ledger"
+				cfg.Dir = "./compiled"
+				cfg.FieldOverwriterParams = []string{"Charge.amount=between(1, 3)", "Charge.at=any"}
+			}),
+
+			want: expectedBetweenRes,
+		},
+		{
+			name: "call field clashing with a param",
+
+			cfg: someConfig(func(cfg *config.InterfaceConfig) {
+				cfg.CallFields = config.CallFieldsConfig{Times: "Rows", Maybe: "Maybe"}
+			}),
+
+			wantErrMsg: "duplicate call descriptor field: Rows of Slice",
+		},
+		{
+			name: "call field clashing with a return",
+
+			cfg: someConfig(func(cfg *config.InterfaceConfig) {
+				cfg.CallFields = config.CallFieldsConfig{Panic: "ReceivedY"}
+			}),
+
+			wantErrMsg: "duplicate call descriptor field: ReceivedY of Multi",
+		},
+		{
+			name: "call fields clashing with each other",
+
+			cfg: someConfig(func(cfg *config.InterfaceConfig) {
+				cfg.CallFields = config.CallFieldsConfig{Run: "Hook", Do: "Hook"}
+			}),
+
+			wantErrMsg: "duplicate call descriptor field: Hook of GetX",
+		},
+		{
+			name: "ordered call clashing with a method",
+
+			cfg: someConfig(func(cfg *config.InterfaceConfig) {
+				cfg.Name = "Queue"
+				cfg.FieldOverwriterParams = nil
+				cfg.Ordered = true
+			}),
+
+			wantErrMsg: "duplicate generated type: queueOrderedCall of QueueOrdered",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := app.Run(tt.cfg)
+			got, err := a.Run(tt.cfg)
 			assert.Equal(t, tt.want, got)
 			if tt.wantErrMsg != "" {
-				assert.Error(t, err, tt.wantErrMsg)
+				assert.ErrorContains(t, err, tt.wantErrMsg)
 			} else {
 				assert.NoError(t, err)
 			}
 		})
 	}
 }
+
+func TestRunSchema(t *testing.T) {
+	t.Parallel()
+
+	a := app.New()
+	tests := []struct {
+		name string
+
+		cfg *config.InterfaceConfig
+
+		want string
+	}{
+		{
+			name: "plain fields",
+
+			cfg: someConfig(func(cfg *config.InterfaceConfig) { cfg.Fixtures = true }),
+
+			want: expectedSchemaRes,
+		},
+		{
+			name: "struct field",
+
+			cfg: someConfig(func(cfg *config.InterfaceConfig) {
+				cfg.Name = "Scanner"
+				cfg.Fixtures = true
+				cfg.FieldOverwriterParams = nil
+			}),
+
+			want: expectedScannerSchemaRes,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := a.RunSchema(tt.cfg)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.want, got)
+
+			var doc any
+			require.NoError(t, json.Unmarshal([]byte(got), &doc))
+			for _, ref := range collectRefs(doc) {
+				assert.NotNil(t, resolveRef(t, doc, ref), ref)
+			}
+		})
+	}
+}
+
+func collectRefs(node any) []string {
+	var res []string
+	switch v := node.(type) {
+	case map[string]any:
+		if ref, ok := v["$ref"].(string); ok {
+			res = append(res, ref)
+		}
+		for _, child := range v {
+			res = append(res, collectRefs(child)...)
+		}
+	case []any:
+		for _, child := range v {
+			res = append(res, collectRefs(child)...)
+		}
+	}
+
+	return res
+}
+
+// resolveRef follows the JSON Pointer of the URI fragment as validators do, it is nil for unknown refs.
+func resolveRef(t *testing.T, doc any, ref string) any {
+	t.Helper()
+
+	fragment, ok := strings.CutPrefix(ref, "#/")
+	require.True(t, ok, ref)
+	pointer, err := url.PathUnescape(fragment)
+	require.NoError(t, err)
+
+	node := doc
+	for _, token := range strings.Split(pointer, "/") {
+		object, ok := node.(map[string]any)
+		if !ok {
+			return nil
+		}
+		node = object[strings.NewReplacer("~1", "/", "~0", "~").Replace(token)]
+	}
+
+	return node
+}
@@ -0,0 +1,36 @@
+package compiled
+
+import (
+	"os"
+	"os/exec"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+const childEnv = "COMPILED_FAILS_CHILD"
+
+// fails runs test in a child process of the test binary, as failures of *testing.T cannot be caught in process,
+// and returns the output of the test. The calling test fails when test does not.
+func fails(t *testing.T, test func(t *testing.T)) string {
+	t.Helper()
+
+	if os.Getenv(childEnv) == t.Name() {
+		test(t)
+
+		return ""
+	}
+
+	parts := strings.Split(t.Name(), "/")
+	for i, part := range parts {
+		parts[i] = "^" + regexp.QuoteMeta(part) + "$"
+	}
+	cmd := exec.CommandContext(t.Context(), os.Args[0], "-test.run="+strings.Join(parts, "/"), "-test.v") //nolint:gosec
+	cmd.Env = append(os.Environ(), childEnv+"="+t.Name())
+	out, err := cmd.CombinedOutput()
+	if err == nil {
+		t.Fatalf("test did not fail:\n%s", out)
+	}
+
+	return string(out)
+}
@@ -0,0 +1,65 @@
+package compiled
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorker_panic(t *testing.T) {
+	t.Parallel()
+
+	errFailed := errors.New("failed")
+	worker := makeWorkerMock(t, &workerCalls{
+		Stop:  []stopCall{{Panic: "boom"}},
+		Merge: []mergeCall{{Panic: fmt.Errorf("m: %w", errFailed)}},
+	})
+	assert.PanicsWithValue(t, "boom", worker.Stop)
+
+	func() {
+		defer func() {
+			err, ok := recover().(error)
+			require.True(t, ok, "the call panics with the error itself")
+			assert.ErrorIs(t, err, errFailed)
+		}()
+		worker.Merge(nil)
+	}()
+}
+
+func TestWorker_delay(t *testing.T) {
+	t.Parallel()
+
+	const delay = 20 * time.Millisecond
+	worker := makeWorkerMock(t, &workerCalls{Report: []reportCall{{ReceivedStatus: "x", Delay: delay}}})
+
+	start := time.Now()
+	x, _, err := worker.Report()
+	assert.GreaterOrEqual(t, time.Since(start), delay)
+	assert.Equal(t, "x", x)
+	require.NoError(t, err)
+}
+
+func TestWorker_blockUntilCtxDone(t *testing.T) {
+	t.Parallel()
+
+	worker := makeWorkerMock(t, &workerCalls{Wait: []waitCall{{ReceivedStatus: "x", BlockUntilCtxDone: true}, {ReceivedStatus: "y"}}})
+
+	ctx, cancel := context.WithCancel(t.Context())
+	done := make(chan string)
+	go func() { done <- worker.Wait(ctx) }()
+
+	select {
+	case <-done:
+		t.Fatal("Wait returned before the context is done")
+	case <-time.After(20 * time.Millisecond):
+	}
+	cancel()
+	assert.Equal(t, "x", <-done)
+
+	assert.Equal(t, "y", worker.Wait(t.Context()), "calls without the field do not block")
+}
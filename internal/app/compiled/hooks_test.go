@@ -0,0 +1,49 @@
+package compiled
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPipeline_run(t *testing.T) {
+	t.Parallel()
+
+	var got []string
+	pipeline := makePipelineMock(t, &pipelineCalls{
+		Feed:  []feedCall{{Rows: []string{"a"}, Run: func(rows []string) { got = rows }}},
+		Flush: []flushCall{{Run: func() { got = append(got, "nothing") }}},
+	})
+	require.NoError(t, pipeline.Feed([]string{"a"}))
+	pipeline.Flush()
+	assert.Equal(t, []string{"a", "nothing"}, got)
+}
+
+func TestPipeline_doTakesPrecedence(t *testing.T) {
+	t.Parallel()
+
+	var ran bool
+	errDo := errors.New("do")
+	pipeline := makePipelineMock(t, &pipelineCalls{
+		Peek: []peekCall{{
+			ReceivedHead: "received",
+			Run:          func(context.Context) { ran = true },
+			Do:           func(context.Context) string { return "done" },
+		}},
+		Stats: []statsCall{{
+			ReceivedHead: "received",
+			Do:           func() (string, int, error) { return "done", 2, errDo },
+		}},
+	})
+
+	assert.Equal(t, "done", pipeline.Peek(t.Context()))
+	assert.True(t, ran, "Run is called along with Do")
+
+	x, y, err := pipeline.Stats()
+	assert.Equal(t, "done", x)
+	assert.Equal(t, 2, y)
+	require.ErrorIs(t, err, errDo)
+}
@@ -0,0 +1,126 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package compiled
+
+import mock "github.com/stretchr/testify/mock"
+
+// mockIndex is an autogenerated mock type for the Index type
+type mockIndex struct {
+	mock.Mock
+}
+
+type mockIndex_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockIndex) EXPECT() *mockIndex_Expecter {
+	return &mockIndex_Expecter{mock: &_m.Mock}
+}
+
+// Lookup provides a mock function with given fields: m
+func (_m *mockIndex) Lookup(m map[string]int) map[string]int {
+	ret := _m.Called(m)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Lookup")
+	}
+
+	var r0 map[string]int
+	if rf, ok := ret.Get(0).(func(map[string]int) map[string]int); ok {
+		r0 = rf(m)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int)
+		}
+	}
+
+	return r0
+}
+
+// mockIndex_Lookup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Lookup'
+type mockIndex_Lookup_Call struct {
+	*mock.Call
+}
+
+// Lookup is a helper method to define mock.On call
+//   - m map[string]int
+func (_e *mockIndex_Expecter) Lookup(m interface{}) *mockIndex_Lookup_Call {
+	return &mockIndex_Lookup_Call{Call: _e.mock.On("Lookup", m)}
+}
+
+func (_c *mockIndex_Lookup_Call) Run(run func(m map[string]int)) *mockIndex_Lookup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(map[string]int))
+	})
+	return _c
+}
+
+func (_c *mockIndex_Lookup_Call) Return(_a0 map[string]int) *mockIndex_Lookup_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockIndex_Lookup_Call) RunAndReturn(run func(map[string]int) map[string]int) *mockIndex_Lookup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Store provides a mock function with given fields: rows
+func (_m *mockIndex) Store(rows []string) error {
+	ret := _m.Called(rows)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Store")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]string) error); ok {
+		r0 = rf(rows)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockIndex_Store_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Store'
+type mockIndex_Store_Call struct {
+	*mock.Call
+}
+
+// Store is a helper method to define mock.On call
+//   - rows []string
+func (_e *mockIndex_Expecter) Store(rows interface{}) *mockIndex_Store_Call {
+	return &mockIndex_Store_Call{Call: _e.mock.On("Store", rows)}
+}
+
+func (_c *mockIndex_Store_Call) Run(run func(rows []string)) *mockIndex_Store_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]string))
+	})
+	return _c
+}
+
+func (_c *mockIndex_Store_Call) Return(_a0 error) *mockIndex_Store_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockIndex_Store_Call) RunAndReturn(run func([]string) error) *mockIndex_Store_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockIndex creates a new instance of mockIndex. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockIndex(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockIndex {
+	mock := &mockIndex{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,222 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package compiled
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockPipeline is an autogenerated mock type for the Pipeline type
+type mockPipeline struct {
+	mock.Mock
+}
+
+type mockPipeline_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockPipeline) EXPECT() *mockPipeline_Expecter {
+	return &mockPipeline_Expecter{mock: &_m.Mock}
+}
+
+// Feed provides a mock function with given fields: rows
+func (_m *mockPipeline) Feed(rows []string) error {
+	ret := _m.Called(rows)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Feed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]string) error); ok {
+		r0 = rf(rows)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockPipeline_Feed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Feed'
+type mockPipeline_Feed_Call struct {
+	*mock.Call
+}
+
+// Feed is a helper method to define mock.On call
+//   - rows []string
+func (_e *mockPipeline_Expecter) Feed(rows interface{}) *mockPipeline_Feed_Call {
+	return &mockPipeline_Feed_Call{Call: _e.mock.On("Feed", rows)}
+}
+
+func (_c *mockPipeline_Feed_Call) Run(run func(rows []string)) *mockPipeline_Feed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]string))
+	})
+	return _c
+}
+
+func (_c *mockPipeline_Feed_Call) Return(_a0 error) *mockPipeline_Feed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockPipeline_Feed_Call) RunAndReturn(run func([]string) error) *mockPipeline_Feed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Flush provides a mock function with no fields
+func (_m *mockPipeline) Flush() {
+	_m.Called()
+}
+
+// mockPipeline_Flush_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Flush'
+type mockPipeline_Flush_Call struct {
+	*mock.Call
+}
+
+// Flush is a helper method to define mock.On call
+func (_e *mockPipeline_Expecter) Flush() *mockPipeline_Flush_Call {
+	return &mockPipeline_Flush_Call{Call: _e.mock.On("Flush")}
+}
+
+func (_c *mockPipeline_Flush_Call) Run(run func()) *mockPipeline_Flush_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *mockPipeline_Flush_Call) Return() *mockPipeline_Flush_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *mockPipeline_Flush_Call) RunAndReturn(run func()) *mockPipeline_Flush_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Peek provides a mock function with given fields: ctx
+func (_m *mockPipeline) Peek(ctx context.Context) string {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Peek")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context) string); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// mockPipeline_Peek_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Peek'
+type mockPipeline_Peek_Call struct {
+	*mock.Call
+}
+
+// Peek is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *mockPipeline_Expecter) Peek(ctx interface{}) *mockPipeline_Peek_Call {
+	return &mockPipeline_Peek_Call{Call: _e.mock.On("Peek", ctx)}
+}
+
+func (_c *mockPipeline_Peek_Call) Run(run func(ctx context.Context)) *mockPipeline_Peek_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *mockPipeline_Peek_Call) Return(head string) *mockPipeline_Peek_Call {
+	_c.Call.Return(head)
+	return _c
+}
+
+func (_c *mockPipeline_Peek_Call) RunAndReturn(run func(context.Context) string) *mockPipeline_Peek_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stats provides a mock function with no fields
+func (_m *mockPipeline) Stats() (string, int, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stats")
+	}
+
+	var r0 string
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func() (string, int, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func() int); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func() error); ok {
+		r2 = rf()
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// mockPipeline_Stats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stats'
+type mockPipeline_Stats_Call struct {
+	*mock.Call
+}
+
+// Stats is a helper method to define mock.On call
+func (_e *mockPipeline_Expecter) Stats() *mockPipeline_Stats_Call {
+	return &mockPipeline_Stats_Call{Call: _e.mock.On("Stats")}
+}
+
+func (_c *mockPipeline_Stats_Call) Run(run func()) *mockPipeline_Stats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *mockPipeline_Stats_Call) Return(head string, size int, err error) *mockPipeline_Stats_Call {
+	_c.Call.Return(head, size, err)
+	return _c
+}
+
+func (_c *mockPipeline_Stats_Call) RunAndReturn(run func() (string, int, error)) *mockPipeline_Stats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockPipeline creates a new instance of mockPipeline. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockPipeline(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockPipeline {
+	mock := &mockPipeline{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
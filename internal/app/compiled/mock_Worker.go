@@ -0,0 +1,224 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package compiled
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockWorker is an autogenerated mock type for the Worker type
+type mockWorker struct {
+	mock.Mock
+}
+
+type mockWorker_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockWorker) EXPECT() *mockWorker_Expecter {
+	return &mockWorker_Expecter{mock: &_m.Mock}
+}
+
+// Merge provides a mock function with given fields: m
+func (_m *mockWorker) Merge(m map[string]int) map[string]int {
+	ret := _m.Called(m)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Merge")
+	}
+
+	var r0 map[string]int
+	if rf, ok := ret.Get(0).(func(map[string]int) map[string]int); ok {
+		r0 = rf(m)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int)
+		}
+	}
+
+	return r0
+}
+
+// mockWorker_Merge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Merge'
+type mockWorker_Merge_Call struct {
+	*mock.Call
+}
+
+// Merge is a helper method to define mock.On call
+//   - m map[string]int
+func (_e *mockWorker_Expecter) Merge(m interface{}) *mockWorker_Merge_Call {
+	return &mockWorker_Merge_Call{Call: _e.mock.On("Merge", m)}
+}
+
+func (_c *mockWorker_Merge_Call) Run(run func(m map[string]int)) *mockWorker_Merge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(map[string]int))
+	})
+	return _c
+}
+
+func (_c *mockWorker_Merge_Call) Return(_a0 map[string]int) *mockWorker_Merge_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockWorker_Merge_Call) RunAndReturn(run func(map[string]int) map[string]int) *mockWorker_Merge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Report provides a mock function with no fields
+func (_m *mockWorker) Report() (string, int, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Report")
+	}
+
+	var r0 string
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func() (string, int, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func() int); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func() error); ok {
+		r2 = rf()
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// mockWorker_Report_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Report'
+type mockWorker_Report_Call struct {
+	*mock.Call
+}
+
+// Report is a helper method to define mock.On call
+func (_e *mockWorker_Expecter) Report() *mockWorker_Report_Call {
+	return &mockWorker_Report_Call{Call: _e.mock.On("Report")}
+}
+
+func (_c *mockWorker_Report_Call) Run(run func()) *mockWorker_Report_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *mockWorker_Report_Call) Return(status string, code int, err error) *mockWorker_Report_Call {
+	_c.Call.Return(status, code, err)
+	return _c
+}
+
+func (_c *mockWorker_Report_Call) RunAndReturn(run func() (string, int, error)) *mockWorker_Report_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with no fields
+func (_m *mockWorker) Stop() {
+	_m.Called()
+}
+
+// mockWorker_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type mockWorker_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+func (_e *mockWorker_Expecter) Stop() *mockWorker_Stop_Call {
+	return &mockWorker_Stop_Call{Call: _e.mock.On("Stop")}
+}
+
+func (_c *mockWorker_Stop_Call) Run(run func()) *mockWorker_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *mockWorker_Stop_Call) Return() *mockWorker_Stop_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *mockWorker_Stop_Call) RunAndReturn(run func()) *mockWorker_Stop_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Wait provides a mock function with given fields: ctx
+func (_m *mockWorker) Wait(ctx context.Context) string {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Wait")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context) string); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// mockWorker_Wait_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Wait'
+type mockWorker_Wait_Call struct {
+	*mock.Call
+}
+
+// Wait is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *mockWorker_Expecter) Wait(ctx interface{}) *mockWorker_Wait_Call {
+	return &mockWorker_Wait_Call{Call: _e.mock.On("Wait", ctx)}
+}
+
+func (_c *mockWorker_Wait_Call) Run(run func(ctx context.Context)) *mockWorker_Wait_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *mockWorker_Wait_Call) Return(status string) *mockWorker_Wait_Call {
+	_c.Call.Return(status)
+	return _c
+}
+
+func (_c *mockWorker_Wait_Call) RunAndReturn(run func(context.Context) string) *mockWorker_Wait_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockWorker creates a new instance of mockWorker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockWorker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockWorker {
+	mock := &mockWorker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,59 @@
+package compiled
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkflowOrdered(t *testing.T) {
+	t.Parallel()
+
+	workflow := makeWorkflowOrderedMock(t, []workflowOrderedCall{
+		stepCall{ReceivedName: "x"},
+		batchCall{Rows: []string{"a", "b"}},
+		stepCall{ReceivedName: "y"},
+	})
+	assert.Equal(t, "x", workflow.Step(t.Context()))
+	require.NoError(t, workflow.Batch([]string{"b", "a"}))
+	assert.Equal(t, "y", workflow.Step(t.Context()))
+}
+
+func TestWorkflowOrdered_outOfOrder(t *testing.T) {
+	t.Parallel()
+
+	out := fails(t, func(t *testing.T) {
+		workflow := makeWorkflowOrderedMock(t, []workflowOrderedCall{stepCall{ReceivedName: "x"}, resetCall{}})
+		workflow.Reset()
+	})
+	assert.Contains(t, out, "Must not be called before")
+}
+
+func TestWorkflowOrdered_unknownDescriptor(t *testing.T) {
+	t.Parallel()
+
+	out := fails(t, func(t *testing.T) {
+		makeWorkflowOrderedMock(t, []workflowOrderedCall{nil})
+	})
+	assert.Contains(t, out, "unexpected call descriptor <nil>")
+}
+
+func TestWorkflow_unordered(t *testing.T) {
+	t.Parallel()
+
+	workflow := makeWorkflowMock(t, &workflowCalls{Step: []stepCall{{ReceivedName: "x"}}, Reset: []resetCall{{}}})
+	workflow.Reset()
+	assert.Equal(t, "x", workflow.Step(t.Context()))
+}
+
+func TestWorkflow_batchMismatch(t *testing.T) {
+	t.Parallel()
+
+	out := fails(t, func(t *testing.T) {
+		workflow := makeWorkflowMock(t, &workflowCalls{Batch: []batchCall{{Rows: []string{"a", "b"}}}})
+		_ = workflow.Batch([]string{"a", "c"})
+	})
+	assert.Contains(t, out, "0: FAIL:  ([]string=[a c]) not matched by func(interface {}) bool")
+	assert.Contains(t, out, `expected elements of []string{"a", "b"} in any order, got []string{"a", "c"}`)
+}
@@ -0,0 +1,84 @@
+package compiled
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeScanner struct{}
+
+func (fakeScanner) Scan(_ context.Context, dest *Row) error {
+	*dest = Row{ID: 2, Name: "impl"}
+
+	return nil
+}
+
+func (fakeScanner) Decode(v any) error {
+	*v.(*int) = 3 //nolint:forcetypeassert
+
+	return nil
+}
+
+func TestScanner_mockSetsOutParams(t *testing.T) {
+	t.Parallel()
+
+	scanner := makeScannerMock(t, &scannerCalls{
+		Scan:   []scanCall{{SetDest: Row{ID: 1, Name: "bob"}}},
+		Decode: []decodeCall{{SetV: Row{ID: 2}}, {}},
+	})
+
+	var row Row
+	require.NoError(t, scanner.Scan(t.Context(), &row))
+	assert.Equal(t, Row{ID: 1, Name: "bob"}, row)
+
+	var decoded Row
+	require.NoError(t, scanner.Decode(&decoded))
+	assert.Equal(t, Row{ID: 2}, decoded)
+
+	untouched := Row{ID: 3}
+	require.NoError(t, scanner.Decode(&untouched))
+	assert.Equal(t, Row{ID: 3}, untouched, "unset SetV leaves the argument as is")
+}
+
+func TestScanner_spyRecordsOutParams(t *testing.T) {
+	t.Parallel()
+
+	spy := spyScanner(t, fakeScanner{}, nil)
+	var row Row
+	require.NoError(t, spy.Scan(t.Context(), &row))
+	var n int
+	require.NoError(t, spy.Decode(&n))
+
+	calls := spy.Calls()
+	assert.Equal(t, []scanCall{{SetDest: Row{ID: 2, Name: "impl"}}}, calls.Scan)
+	assert.Equal(t, []decodeCall{{SetV: 3}}, calls.Decode)
+}
+
+func TestScanner_spyReturnsOutParams(t *testing.T) {
+	t.Parallel()
+
+	spy := spyScanner(t, nil, &scannerCalls{Scan: []scanCall{{SetDest: Row{ID: 4}}}})
+	var row Row
+	require.NoError(t, spy.Scan(t.Context(), &row))
+	assert.Equal(t, Row{ID: 4}, row)
+	assert.Equal(t, []scanCall{{SetDest: Row{ID: 4}}}, spy.Calls().Scan)
+}
+
+func TestScanner_nilOutParams(t *testing.T) {
+	t.Parallel()
+
+	scanner := makeScannerMock(t, &scannerCalls{
+		Scan:   []scanCall{{SetDest: Row{ID: 1}}},
+		Decode: []decodeCall{{SetV: Row{ID: 2}}},
+	})
+	require.NoError(t, scanner.Scan(t.Context(), nil))
+	require.NoError(t, scanner.Decode((*Row)(nil)))
+
+	spy := spyScanner(t, nil, &scannerCalls{Scan: []scanCall{{SetDest: Row{ID: 4}}}, Decode: []decodeCall{{SetV: 5}}})
+	require.NoError(t, spy.Scan(t.Context(), nil))
+	require.NoError(t, spy.Decode(nil))
+	assert.Equal(t, []scanCall{{}}, spy.Calls().Scan, "nothing is written into nil")
+}
@@ -0,0 +1,51 @@
+package compiled
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xgamtx/go-mockery-descriptor/pkg/assessor"
+)
+
+// hasKey is a matcher assessor does not know.
+type hasKey string
+
+func (k hasKey) Matches(argument any) bool {
+	m, ok := argument.(map[string]int)
+	_, found := m[string(k)]
+
+	return ok && found
+}
+
+func TestIndex_override(t *testing.T) {
+	t.Parallel()
+
+	index := makeIndexMock(t, &indexCalls{
+		Lookup: []lookupCall{
+			{M: map[string]int{"a": 1}, ReceivedR0: map[string]int{"by": 1}},
+			{MMatcher: hasKey("b"), ReceivedR0: map[string]int{"by": 2}},
+		},
+		Store: []storeCall{
+			{Rows: []string{"a", "b"}},
+			{RowsMatcher: assessor.Len(3)},
+		},
+	})
+
+	assert.Equal(t, map[string]int{"by": 2}, index.Lookup(map[string]int{"b": 5}))
+	assert.Equal(t, map[string]int{"by": 1}, index.Lookup(map[string]int{"a": 1}))
+	require.NoError(t, index.Store([]string{"x", "y", "z"}))
+	require.NoError(t, index.Store([]string{"b", "a"}))
+}
+
+func TestIndex_overrideMismatch(t *testing.T) {
+	t.Parallel()
+
+	out := fails(t, func(t *testing.T) {
+		index := makeIndexMock(t, &indexCalls{Store: []storeCall{{Rows: []string{"a"}, RowsMatcher: assessor.Len(2)}}})
+		_ = index.Store([]string{"a"})
+	})
+	assert.Contains(t, out, "0: FAIL:  ([]string=[a]) not matched")
+	assert.Contains(t, out, `expected length 2, got []string{"a"}`, "the failure explains the mismatch")
+}
@@ -0,0 +1,87 @@
+// Code generated by go-mockery-descriptor v1.0.0. DO NOT EDIT.
+
+package compiled
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type feedCall struct {
+	Rows        []string
+	ReceivedErr error
+	Run         func(rows []string)
+	Do          func(rows []string) error
+}
+
+type flushCall struct {
+	Run func()
+}
+
+type peekCall struct {
+	ReceivedHead string
+	Run          func(ctx context.Context)
+	Do           func(ctx context.Context) string
+}
+
+type statsCall struct {
+	ReceivedHead string
+	ReceivedSize int
+	ReceivedErr  error
+	Run          func()
+	Do           func() (string, int, error)
+}
+
+type pipelineCalls struct {
+	Feed  []feedCall
+	Flush []flushCall
+	Peek  []peekCall
+	Stats []statsCall
+}
+
+func makePipelineMock(t *testing.T, calls *pipelineCalls) Pipeline {
+	t.Helper()
+	m := newMockPipeline(t)
+	anyCtx := mock.Anything
+	for _, call := range calls.Feed {
+		expectation := m.EXPECT().Feed(call.Rows).Return(call.ReceivedErr)
+		if call.Run != nil {
+			expectation.Run(call.Run)
+		}
+		if call.Do != nil {
+			expectation.RunAndReturn(call.Do)
+		}
+		expectation.Once()
+	}
+	for _, call := range calls.Flush {
+		expectation := m.EXPECT().Flush().Return()
+		if call.Run != nil {
+			expectation.Run(call.Run)
+		}
+		expectation.Once()
+	}
+	for _, call := range calls.Peek {
+		expectation := m.EXPECT().Peek(anyCtx).Return(call.ReceivedHead)
+		if call.Run != nil {
+			expectation.Run(call.Run)
+		}
+		if call.Do != nil {
+			expectation.RunAndReturn(call.Do)
+		}
+		expectation.Once()
+	}
+	for _, call := range calls.Stats {
+		expectation := m.EXPECT().Stats().Return(call.ReceivedHead, call.ReceivedSize, call.ReceivedErr)
+		if call.Run != nil {
+			expectation.Run(call.Run)
+		}
+		if call.Do != nil {
+			expectation.RunAndReturn(call.Do)
+		}
+		expectation.Once()
+	}
+
+	return m
+}
@@ -0,0 +1,63 @@
+package compiled
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPoller_times(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		call  pollCall
+		calls int
+	}{
+		{name: "once", call: pollCall{}, calls: 1},
+		{name: "exactly", call: pollCall{Times: 3}, calls: 3},
+		{name: "at_least_once", call: pollCall{Times: -1}, calls: 5},
+		{name: "maybe_none", call: pollCall{Maybe: true}},
+		{name: "maybe_any", call: pollCall{Maybe: true}, calls: 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			poller := makePollerMock(t, &pollerCalls{Poll: []pollCall{tt.call}})
+			for range tt.calls {
+				poller.Poll()
+			}
+		})
+	}
+}
+
+func TestPoller_timesFailures(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		call  pollCall
+		calls int
+
+		wantOut string
+	}{
+		{name: "missing", call: pollCall{Times: 2}, calls: 1, wantOut: "The code you are testing needs to make 1 more call(s)"},
+		{name: "exceeded", call: pollCall{Times: 2}, calls: 3, wantOut: "mock: The method has been called over 2 times"},
+		{name: "none_of_at_least_once", call: pollCall{Times: -1}, wantOut: "FAIL:\tPoll()"},
+		{name: "maybe_with_times", call: pollCall{Times: 2, Maybe: true}, wantOut: "Poll: Maybe cannot be combined with Times 2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			out := fails(t, func(t *testing.T) {
+				poller := makePollerMock(t, &pollerCalls{Poll: []pollCall{tt.call}})
+				for range tt.calls {
+					poller.Poll()
+				}
+			})
+			assert.Contains(t, out, tt.wantOut)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package compiled
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepo_sameTx(t *testing.T) {
+	t.Parallel()
+
+	repo := makeRepoMock(t, &repoCalls{Save: []saveCall{{Row: Row{ID: 1}}, {Row: Row{ID: 2}}}})
+	tx := &sql.Tx{}
+	require.NoError(t, repo.Save(nil, tx, Row{ID: 1}))
+	require.NoError(t, repo.Save(nil, tx, Row{ID: 2}))
+}
+
+func TestRepo_otherTx(t *testing.T) {
+	t.Parallel()
+
+	out := fails(t, func(t *testing.T) {
+		repo := makeRepoMock(t, &repoCalls{Save: []saveCall{{Row: Row{ID: 1}}, {Row: Row{ID: 2}}}})
+		_ = repo.Save(nil, &sql.Tx{}, Row{ID: 1})
+		_ = repo.Save(nil, &sql.Tx{}, Row{ID: 2})
+	})
+	assert.Contains(t, out, "mock: Unexpected Method Call")
+	assert.Contains(t, out, "1: FAIL:  (*sql.Tx=")
+}
+
+func TestRepo_txPerMock(t *testing.T) {
+	t.Parallel()
+
+	first := makeRepoMock(t, &repoCalls{Save: []saveCall{{Row: Row{ID: 1}}}})
+	second := makeRepoMock(t, &repoCalls{Save: []saveCall{{Row: Row{ID: 1}}}})
+	require.NoError(t, first.Save(nil, &sql.Tx{}, Row{ID: 1}))
+	require.NoError(t, second.Save(nil, &sql.Tx{}, Row{ID: 1}))
+}
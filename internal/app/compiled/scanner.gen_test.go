@@ -0,0 +1,151 @@
+// Code generated by go-mockery-descriptor v1.0.0. DO NOT EDIT.
+
+package compiled
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type scanCall struct {
+	SetDest     Row
+	ReceivedErr error
+}
+
+type decodeCall struct {
+	SetV        any
+	ReceivedErr error
+}
+
+type scannerCalls struct {
+	Scan   []scanCall
+	Decode []decodeCall
+}
+
+func makeScannerMock(t *testing.T, calls *scannerCalls) Scanner {
+	t.Helper()
+	m := newMockScanner(t)
+	anyCtx := mock.Anything
+	for _, call := range calls.Scan {
+		call := call // Run outlives the iteration, modules below go 1.22 share the loop variable
+		expectation := m.EXPECT().Scan(anyCtx, mock.IsType((*Row)(nil))).Return(call.ReceivedErr)
+		expectation.Run(func(ctx context.Context, dest *Row) {
+			if dest != nil {
+				*dest = call.SetDest
+			}
+		})
+		expectation.Once()
+	}
+	for _, call := range calls.Decode {
+		call := call // Run outlives the iteration, modules below go 1.22 share the loop variable
+		expectation := m.EXPECT().Decode(mock.Anything).Return(call.ReceivedErr)
+		expectation.Run(func(v any) {
+			if call.SetV != nil {
+				if _dst := reflect.ValueOf(v); _dst.Kind() == reflect.Pointer && !_dst.IsNil() {
+					_dst.Elem().Set(reflect.ValueOf(call.SetV))
+				}
+			}
+		})
+		expectation.Once()
+	}
+
+	return m
+}
+
+type scannerSpy struct {
+	t       *testing.T
+	impl    Scanner
+	returns *scannerCalls
+	mu      sync.Mutex
+	calls   scannerCalls
+}
+
+var _ Scanner = (*scannerSpy)(nil)
+
+func spyScanner(t *testing.T, impl Scanner, returns *scannerCalls) *scannerSpy {
+	t.Helper()
+	if returns == nil {
+		returns = &scannerCalls{}
+	}
+
+	return &scannerSpy{t: t, impl: impl, returns: returns}
+}
+
+func (_s *scannerSpy) Calls() scannerCalls {
+	_s.mu.Lock()
+	defer _s.mu.Unlock()
+
+	return scannerCalls{
+		Scan:   slices.Clone(_s.calls.Scan),
+		Decode: slices.Clone(_s.calls.Decode),
+	}
+}
+
+func (_s *scannerSpy) Scan(ctx context.Context, dest *Row) error {
+	_s.t.Helper()
+	_call := scanCall{}
+
+	_s.mu.Lock()
+	_idx := len(_s.calls.Scan)
+	_s.calls.Scan = append(_s.calls.Scan, _call)
+	_s.mu.Unlock()
+
+	switch {
+	case _s.impl != nil:
+		_call.ReceivedErr = _s.impl.Scan(ctx, dest)
+	case _idx < len(_s.returns.Scan):
+		_call.ReceivedErr = _s.returns.Scan[_idx].ReceivedErr
+		if dest != nil {
+			*dest = _s.returns.Scan[_idx].SetDest
+		}
+	default:
+		_s.t.Errorf("unexpected call #%d of Scanner.Scan", _idx+1)
+	}
+	if dest != nil {
+		_call.SetDest = *dest
+	}
+
+	_s.mu.Lock()
+	_s.calls.Scan[_idx] = _call
+	_s.mu.Unlock()
+
+	return _call.ReceivedErr
+}
+
+func (_s *scannerSpy) Decode(v any) error {
+	_s.t.Helper()
+	_call := decodeCall{}
+
+	_s.mu.Lock()
+	_idx := len(_s.calls.Decode)
+	_s.calls.Decode = append(_s.calls.Decode, _call)
+	_s.mu.Unlock()
+
+	switch {
+	case _s.impl != nil:
+		_call.ReceivedErr = _s.impl.Decode(v)
+	case _idx < len(_s.returns.Decode):
+		_call.ReceivedErr = _s.returns.Decode[_idx].ReceivedErr
+		if _s.returns.Decode[_idx].SetV != nil {
+			if _dst := reflect.ValueOf(v); _dst.Kind() == reflect.Pointer && !_dst.IsNil() {
+				_dst.Elem().Set(reflect.ValueOf(_s.returns.Decode[_idx].SetV))
+			}
+		}
+	default:
+		_s.t.Errorf("unexpected call #%d of Scanner.Decode", _idx+1)
+	}
+	if _v := reflect.ValueOf(v); _v.Kind() == reflect.Pointer && !_v.IsNil() {
+		_call.SetV = _v.Elem().Interface()
+	}
+
+	_s.mu.Lock()
+	_s.calls.Decode[_idx] = _call
+	_s.mu.Unlock()
+
+	return _call.ReceivedErr
+}
@@ -0,0 +1,99 @@
+// Package compiled holds interfaces whose generated mocks are compiled and driven by tests.
+// Descriptors are named after methods, so methods of different interfaces have different names.
+package compiled
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+type Row struct {
+	ID   int
+	Name string
+}
+
+type TraceContext interface {
+	context.Context
+	TraceID() string
+}
+
+// Workflow is generated with the ordered mock.
+//
+//go:generate mockery --name=Workflow --inpackage --with-expecter=true --structname=mockWorkflow
+type Workflow interface {
+	Step(ctx context.Context) (name string)
+	Batch(rows []string) error
+	Reset()
+}
+
+// Poller is generated with repetition call fields.
+//
+//go:generate mockery --name=Poller --inpackage --with-expecter=true --structname=mockPoller
+type Poller interface {
+	Poll()
+}
+
+// Pipeline is generated with Run and Do call fields.
+//
+//go:generate mockery --name=Pipeline --inpackage --with-expecter=true --structname=mockPipeline
+type Pipeline interface {
+	Feed(rows []string) error
+	Flush()
+	Peek(ctx context.Context) (head string)
+	Stats() (head string, size int, err error)
+}
+
+// Worker is generated with Panic, Delay and BlockUntilCtxDone call fields.
+//
+//go:generate mockery --name=Worker --inpackage --with-expecter=true --structname=mockWorker
+type Worker interface {
+	Stop()
+	Merge(m map[string]int) map[string]int
+	Report() (status string, code int, err error)
+	Wait(ctx context.Context) (status string)
+}
+
+// Index is generated with matcher overriding fields.
+//
+//go:generate mockery --name=Index --inpackage --with-expecter=true --structname=mockIndex
+type Index interface {
+	Lookup(m map[string]int) map[string]int
+	Store(rows []string) error
+}
+
+// Scanner is generated with out params.
+//
+//go:generate mockery --name=Scanner --inpackage --with-expecter=true --structname=mockScanner
+type Scanner interface {
+	Scan(ctx context.Context, dest *Row) error
+	Decode(v any) error
+}
+
+// Repo is generated with a param matched by the same instance.
+//
+//go:generate mockery --name=Repo --inpackage --with-expecter=true --structname=mockRepo
+type Repo interface {
+	Save(ctx TraceContext, tx *sql.Tx, row Row) error
+}
+
+// Events is generated with a text matcher.
+//
+//go:generate mockery --name=Events --inpackage --with-expecter=true --structname=mockEvents
+type Events interface {
+	Publish(ctx context.Context, topic string, payload []byte) error
+}
+
+// Ledger is generated with a tolerance matcher.
+//
+//go:generate mockery --name=Ledger --inpackage --with-expecter=true --structname=mockLedger
+type Ledger interface {
+	Charge(amount float64, at time.Time, attempt int) error
+}
+
+// Dispatcher is generated with a spy of variadic and channel params.
+//
+//go:generate mockery --name=Dispatcher --inpackage --with-expecter=true --structname=mockDispatcher
+type Dispatcher interface {
+	Do(ch chan int, opts ...string) error
+}
@@ -0,0 +1,41 @@
+package compiled
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvents_payload(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		call    publishCall
+		payload []byte
+	}{
+		{name: "unset", call: publishCall{Topic: "users"}},
+		{name: "unset_null", call: publishCall{Topic: "users"}, payload: []byte("null")},
+		{name: "set", call: publishCall{Topic: "users", Payload: []byte(`{"id": 1}`)}, payload: []byte(`{ "id":1 }`)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			events := makeEventsMock(t, &eventsCalls{Publish: []publishCall{tt.call}})
+			require.NoError(t, events.Publish(t.Context(), "users", tt.payload))
+		})
+	}
+}
+
+func TestEvents_unsetPayloadMismatch(t *testing.T) {
+	t.Parallel()
+
+	out := fails(t, func(t *testing.T) {
+		events := makeEventsMock(t, &eventsCalls{Publish: []publishCall{{Topic: "users"}}})
+		_ = events.Publish(t.Context(), "users", []byte(`{}`))
+	})
+	assert.Contains(t, out, "mock: Unexpected Method Call")
+	assert.Contains(t, out, "2: FAIL:  ([]uint8=[123 125]) not matched")
+}
@@ -0,0 +1,28 @@
+package compiled
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLedger_between(t *testing.T) {
+	t.Parallel()
+
+	ledger := makeLedgerMock(t, &ledgerCalls{Charge: []chargeCall{{Attempt: 1}, {Attempt: 2}}})
+	require.NoError(t, ledger.Charge(1, time.Now(), 1))
+	require.NoError(t, ledger.Charge(2.5, time.Now(), 2))
+}
+
+func TestLedger_notBetween(t *testing.T) {
+	t.Parallel()
+
+	out := fails(t, func(t *testing.T) {
+		ledger := makeLedgerMock(t, &ledgerCalls{Charge: []chargeCall{{Attempt: 1}}})
+		_ = ledger.Charge(3.5, time.Now(), 1)
+	})
+	assert.Contains(t, out, "0: FAIL:  (float64=3.5) not matched")
+	assert.Contains(t, out, "expected between 1 and 3, got 3.5", "the failure explains the mismatch")
+}
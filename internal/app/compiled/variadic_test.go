@@ -0,0 +1,40 @@
+package compiled
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var errClosed = errors.New("closed")
+
+type fakeDispatcher struct {
+	opts []string
+}
+
+func (d *fakeDispatcher) Do(_ chan int, opts ...string) error {
+	d.opts = opts
+
+	return errClosed
+}
+
+func TestDispatcher_spyForwardsVariadicArgs(t *testing.T) {
+	t.Parallel()
+
+	impl := &fakeDispatcher{}
+	spy := spyDispatcher(t, impl, nil)
+	ch := make(chan int)
+	assert.ErrorIs(t, spy.Do(ch, "fast", "retry"), errClosed)
+
+	assert.Equal(t, []string{"fast", "retry"}, impl.opts)
+	assert.Equal(t, []doCall{{Ch: ch, Opts: []string{"fast", "retry"}, ReceivedErr: errClosed}}, spy.Calls().Do)
+}
+
+func TestDispatcher_spyWithoutVariadicArgs(t *testing.T) {
+	t.Parallel()
+
+	spy := spyDispatcher(t, nil, &dispatcherCalls{Do: []doCall{{ReceivedErr: errClosed}}})
+	assert.ErrorIs(t, spy.Do(nil), errClosed)
+	assert.Equal(t, []doCall{{ReceivedErr: errClosed}}, spy.Calls().Do)
+}
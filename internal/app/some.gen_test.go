@@ -44,7 +44,8 @@ type someCalls struct {
 
 func makeSomeMock(t *testing.T, calls *someCalls) Some {
 	t.Helper()
-	m := newMockSome(t)
+	reporter := assessor.NewReporter(t)
+	m := newMockSome(reporter)
 	anyCtx := mock.Anything
 	for _, call := range calls.GetX {
 		m.EXPECT().GetX(anyCtx).Return(call.ReceivedX).Once()
@@ -56,7 +57,7 @@ func makeSomeMock(t *testing.T, calls *someCalls) Some {
 		m.EXPECT().M(call.M).Return(call.ReceivedR0).Once()
 	}
 	for _, call := range calls.Slice {
-		m.EXPECT().Slice(assessor.ElementsMatch(call.Rows)).Return(call.ReceivedErr).Once()
+		m.EXPECT().Slice(reporter.Arg(assessor.ElementsMatch(call.Rows))).Return(call.ReceivedErr).Once()
 	}
 	for range calls.Anything {
 		m.EXPECT().Anything(mock.Anything).Return().Once()
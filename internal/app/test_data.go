@@ -1,6 +1,10 @@
 package app
 
-import "context"
+import (
+	"context"
+	"database/sql"
+	"time"
+)
 
 type Some interface {
 	GetX(ctx context.Context) string
@@ -10,3 +14,35 @@ type Some interface {
 	Anything(v int)
 	Multi() (string, int, error)
 }
+
+type Row struct {
+	ID   int
+	Name string
+}
+
+type Scanner interface {
+	Scan(ctx context.Context, dest *Row) error
+	Decode(v any) error
+}
+
+type TraceContext interface {
+	context.Context
+	TraceID() string
+}
+
+type Repo interface {
+	Save(ctx TraceContext, tx *sql.Tx, row Row) error
+}
+
+type Ledger interface {
+	Charge(amount float64, at time.Time, attempt int) error
+}
+
+type Jobs interface {
+	MarkFailed(ctx context.Context, id string, err error) error
+}
+
+// Queue has a method whose descriptor is named like the list item of the ordered mock.
+type Queue interface {
+	QueueOrdered(id string) error
+}
@@ -13,7 +13,14 @@ type Config struct {
 	Output          string `mapstructure:"output"`
 	ConstructorName string `mapstructure:"constructor-name"`
 	PackageName     string `mapstructure:"package-name"`
+	SchemaOutput    string `mapstructure:"schema-output"`
 	Interfaces      []InterfaceConfig
+
+	MatcherAliases map[string]MatcherAlias `mapstructure:"matcher-aliases"`
+	AnythingTypes  []string                `mapstructure:"anything-types"`
+	SameInstance   []string                `mapstructure:"same-instance"`
+	Equality       *EqualityConfig         `mapstructure:"equality"`
+	ErrorIs        bool                    `mapstructure:"error-is"`
 }
 
 type InterfaceConfig struct {
@@ -21,10 +28,86 @@ type InterfaceConfig struct {
 	Output          string `mapstructure:"output"`
 	ConstructorName string `mapstructure:"constructor-name"`
 	PackageName     string `mapstructure:"package-name"`
+	SchemaOutput    string `mapstructure:"schema-output"`
+
+	MatcherAliases map[string]MatcherAlias `mapstructure:"matcher-aliases"`
+	AnythingTypes  []string                `mapstructure:"anything-types"`
+	SameInstance   []string                `mapstructure:"same-instance"`
+	Equality       *EqualityConfig         `mapstructure:"equality"`
+	// ErrorIs is inherited from the top level unless set, so false turns it off for the interface.
+	ErrorIs *bool `mapstructure:"error-is"`
+
+	Name                  string                `mapstructure:"name"`
+	FieldOverwriterParams []string              `mapstructure:"field-overwriter-param"`
+	FieldOverwriterRules  []FieldOverwriterRule `mapstructure:"field-overwriter-rules"`
+	RenameReturns         map[string]string     `mapstructure:"rename-returns"`
+	OutParams             []string              `mapstructure:"out-params"`
+	Spy                   bool                  `mapstructure:"spy"`
+	Recorder              bool                  `mapstructure:"recorder"`
+	Fixtures              bool                  `mapstructure:"fixtures"`
+	Ordered               bool                  `mapstructure:"ordered"`
+	CallFields            CallFieldsConfig      `mapstructure:"call-fields"`
+}
+
+// FieldOverwriterRule applies the matcher to params of all methods selected by the rule.
+type FieldOverwriterRule struct {
+	Method       string `mapstructure:"method"`
+	MethodRegexp string `mapstructure:"method-regexp"`
+	Param        string `mapstructure:"param"`
+	Type         string `mapstructure:"type"`
+	Context      bool   `mapstructure:"context"`
+	Matcher      string `mapstructure:"matcher"`
+}
+
+// EqualityConfig makes plain params matched by assessor.Equal with the options instead of testify's equality,
+// setting any key enables it unless enabled is false. A block of an interface replaces the top level one.
+type EqualityConfig struct {
+	Enabled          *bool   `mapstructure:"enabled"`
+	IgnoreUnexported bool    `mapstructure:"ignore-unexported"`
+	NilEqualsEmpty   bool    `mapstructure:"nil-equals-empty"`
+	UseEqualMethods  bool    `mapstructure:"use-equal-methods"`
+	FloatTolerance   float64 `mapstructure:"float-tolerance"`
+}
+
+func (cfg *EqualityConfig) IsEnabled() bool {
+	if cfg == nil {
+		return false
+	}
+	if cfg.Enabled != nil {
+		return *cfg.Enabled
+	}
+
+	return cfg.IgnoreUnexported || cfg.NilEqualsEmpty || cfg.UseEqualMethods || cfg.FloatTolerance != 0
+}
 
-	Name                  string            `mapstructure:"name"`
-	FieldOverwriterParams []string          `mapstructure:"field-overwriter-param"`
-	RenameReturns         map[string]string `mapstructure:"rename-returns"`
+// IsErrorIs reports whether error params are matched by errors.Is.
+func (cfg *InterfaceConfig) IsErrorIs() bool {
+	return cfg.ErrorIs != nil && *cfg.ErrorIs
+}
+
+// MatcherAlias registers a matcher usable in field overwriter params by a short name.
+type MatcherAlias struct {
+	Path  string `mapstructure:"path"`
+	Func  string `mapstructure:"func"`
+	Shape string `mapstructure:"shape"`
+}
+
+// CallFieldsConfig contains names of optional fields added to every call descriptor, empty names disable the fields.
+type CallFieldsConfig struct {
+	Times string `mapstructure:"times"`
+	Maybe string `mapstructure:"maybe"`
+	Run   string `mapstructure:"run"`
+	Do    string `mapstructure:"do"`
+	Panic string `mapstructure:"panic"`
+	Delay string `mapstructure:"delay"`
+
+	BlockUntilCtxDone string `mapstructure:"block-until-ctx-done"`
+	// MatcherSuffix is appended to names of param fields to get names of their matcher fields.
+	MatcherSuffix string `mapstructure:"matcher-suffix"`
+	// Ctx names the field replacing the matcher of the context param for a single call.
+	Ctx string `mapstructure:"ctx"`
+	// CapturePrefix is prepended to names of params to get names of fields receiving their arguments.
+	CapturePrefix string `mapstructure:"capture-prefix"`
 }
 
 func (cfg *Config) Init() {
@@ -41,6 +124,30 @@ func (cfg *Config) Init() {
 		if cfg.Interfaces[i].PackageName == "" {
 			cfg.Interfaces[i].PackageName = cfg.PackageName
 		}
+		if cfg.Interfaces[i].SchemaOutput == "" {
+			cfg.Interfaces[i].SchemaOutput = cfg.SchemaOutput
+		}
+		if cfg.Interfaces[i].AnythingTypes == nil {
+			cfg.Interfaces[i].AnythingTypes = cfg.AnythingTypes
+		}
+		if cfg.Interfaces[i].SameInstance == nil {
+			cfg.Interfaces[i].SameInstance = cfg.SameInstance
+		}
+		if cfg.Interfaces[i].Equality == nil {
+			cfg.Interfaces[i].Equality = cfg.Equality
+		}
+		if cfg.Interfaces[i].ErrorIs == nil {
+			errorIs := cfg.ErrorIs
+			cfg.Interfaces[i].ErrorIs = &errorIs
+		}
+		for name, alias := range cfg.MatcherAliases {
+			if cfg.Interfaces[i].MatcherAliases == nil {
+				cfg.Interfaces[i].MatcherAliases = make(map[string]MatcherAlias, len(cfg.MatcherAliases))
+			}
+			if _, ok := cfg.Interfaces[i].MatcherAliases[name]; !ok {
+				cfg.Interfaces[i].MatcherAliases[name] = alias
+			}
+		}
 	}
 }
 
@@ -58,6 +165,7 @@ func initDefaults() {
 	viper.SetDefault("constructor-name", "newMock{{ . }}")
 	viper.SetDefault("output", "{{ . }}.mockery-helper_test.go")
 	viper.SetDefault("package-name", "{{ . }}_test")
+	viper.SetDefault("schema-output", "{{ . }}.calls.schema.json")
 }
 
 func New() (*Config, error) {
@@ -0,0 +1,123 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func parse(t *testing.T, yaml string) *Config {
+	t.Helper()
+
+	v := viper.NewWithOptions(viper.KeyDelimiter("::"))
+	v.SetConfigType("yaml")
+	require.NoError(t, v.ReadConfig(strings.NewReader(yaml)))
+
+	var cfg Config
+	require.NoError(t, v.Unmarshal(&cfg))
+	cfg.Init()
+
+	return &cfg
+}
+
+func TestInit_equality(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		yaml string
+
+		wantEnabled bool
+	}{
+		{
+			name: "disabled",
+			yaml: "interfaces:\n  - name: Some\n",
+		},
+		{
+			name: "inherited",
+			yaml: "equality:\n  nil-equals-empty: true\ninterfaces:\n  - name: Some\n",
+
+			wantEnabled: true,
+		},
+		{
+			name: "replaced by interface",
+			yaml: "equality:\n  nil-equals-empty: true\ninterfaces:\n  - name: Some\n    equality:\n      float-tolerance: 0.1\n",
+
+			wantEnabled: true,
+		},
+		{
+			name: "disabled by interface",
+			yaml: "equality:\n  nil-equals-empty: true\ninterfaces:\n  - name: Some\n    equality:\n      enabled: false\n",
+		},
+		{
+			name: "enabled without options",
+			yaml: "interfaces:\n  - name: Some\n    equality:\n      enabled: true\n",
+
+			wantEnabled: true,
+		},
+		{
+			name: "options disabled explicitly",
+			yaml: "interfaces:\n  - name: Some\n    equality:\n      enabled: false\n      use-equal-methods: true\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := parse(t, tt.yaml)
+			require.Len(t, cfg.Interfaces, 1)
+			require.Equal(t, tt.wantEnabled, cfg.Interfaces[0].Equality.IsEnabled())
+		})
+	}
+}
+
+func TestInit_equalityReplaced(t *testing.T) {
+	t.Parallel()
+
+	cfg := parse(t, "equality:\n  nil-equals-empty: true\ninterfaces:\n  - name: Some\n    equality:\n      float-tolerance: 0.1\n")
+	require.False(t, cfg.Interfaces[0].Equality.NilEqualsEmpty)
+	require.InDelta(t, 0.1, cfg.Interfaces[0].Equality.FloatTolerance, 0)
+}
+
+func TestInit_errorIs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		yaml string
+
+		want bool
+	}{
+		{
+			name: "disabled",
+			yaml: "interfaces:\n  - name: Some\n",
+		},
+		{
+			name: "inherited",
+			yaml: "error-is: true\ninterfaces:\n  - name: Some\n",
+
+			want: true,
+		},
+		{
+			name: "enabled by interface",
+			yaml: "interfaces:\n  - name: Some\n    error-is: true\n",
+
+			want: true,
+		},
+		{
+			name: "disabled by interface",
+			yaml: "error-is: true\ninterfaces:\n  - name: Some\n    error-is: false\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := parse(t, tt.yaml)
+			require.Len(t, cfg.Interfaces, 1)
+			require.Equal(t, tt.want, cfg.Interfaces[0].IsErrorIs())
+		})
+	}
+}
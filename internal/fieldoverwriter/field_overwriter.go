@@ -2,47 +2,330 @@ package fieldoverwriter
 
 import (
 	"errors"
+	"fmt"
+	"go/ast"
+	goparser "go/parser"
+	"go/token"
+	"go/types"
+	"path"
 	"regexp"
 	"strconv"
 	"strings"
+	"text/template"
+
+	"github.com/xgamtx/go-mockery-descriptor/internal/parser"
 )
 
 const (
 	stdFuncOneOf             = "oneOf"
 	stdFunctionElementsMatch = "elementsMatch"
 	stdFunctionAny           = "any"
+	stdFuncFieldsMatch       = "fieldsMatch"
+	stdFuncIgnoreFields      = "ignoreFields"
+
+	stdFuncJSONEq    = "jsonEq"
+	stdFuncYAMLEq    = "yamlEq"
+	stdFuncRegexp    = "regexp"
+	stdFuncHasPrefix = "hasPrefix"
+	stdFuncContains  = "contains"
+	stdFuncSQLEq     = "sqlEq"
+
+	stdFuncInDelta        = "inDelta"
+	stdFuncInEpsilon      = "inEpsilon"
+	stdFuncWithinDuration = "withinDuration"
+	stdFuncAfter          = "after"
+	stdFuncBefore         = "before"
+	stdFuncBetween        = "between"
+
+	stdFuncSuperset       = "superset"
+	stdFuncSubset         = "subset"
+	stdFuncMapContains    = "mapContains"
+	stdFuncUnorderedEqual = "unorderedEqual"
+	stdFuncLen            = "len"
+
+	// StdFuncErrorIs is applied to all error params when the generator is asked to.
+	StdFuncErrorIs       = "errorIs"
+	stdFuncErrorContains = "errorContains"
+
+	stdFuncContextHasDeadline  = "contextHasDeadline"
+	stdFuncContextNotCancelled = "contextNotCancelled"
+	stdFuncContextValue        = "contextValue"
 )
 
 type stdFuncDescription struct {
 	Name         string
 	Path         string
 	TypeModifier func(originalType string) string
+	// Called marks matcher constructors, they are called even without arguments.
+	Called bool
+	// ArgsRequired marks matchers without a field, which are built from arguments only.
+	ArgsRequired bool
+	// EqualOptions marks matchers taking options of assessor.Equal after the field.
+	EqualOptions bool
 }
 
+const assessorPath = "github.com/xgamtx/go-mockery-descriptor/pkg/assessor"
+
 var stdFunctions = map[string]stdFuncDescription{ //nolint:gochecknoglobals
 	stdFuncOneOf: {
 		Name:         "assessor.OneOf",
-		Path:         "github.com/xgamtx/go-mockery-descriptor/pkg/assessor",
+		Path:         assessorPath,
 		TypeModifier: func(originalType string) string { return "[]" + originalType },
+		EqualOptions: true,
 	},
 	stdFunctionElementsMatch: {
 		Name:         "assessor.ElementsMatch",
-		Path:         "github.com/xgamtx/go-mockery-descriptor/pkg/assessor",
+		Path:         assessorPath,
 		TypeModifier: func(originalType string) string { return originalType },
+		EqualOptions: true,
 	},
 	stdFunctionAny: {
 		Name:         "mock.Anything",
 		Path:         "github.com/stretchr/testify/mock",
 		TypeModifier: func(string) string { return "" },
 	},
+	stdFuncFieldsMatch: {
+		Name:         "assessor.FieldsMatch",
+		Path:         assessorPath,
+		TypeModifier: func(originalType string) string { return originalType },
+	},
+	stdFuncIgnoreFields: {
+		Name:         "assessor.IgnoreFields",
+		Path:         assessorPath,
+		TypeModifier: func(originalType string) string { return originalType },
+	},
+	stdFuncJSONEq: {
+		Name:         "assessor.JSONEq",
+		Path:         assessorPath,
+		TypeModifier: func(originalType string) string { return originalType },
+	},
+	stdFuncYAMLEq: {
+		Name:         "assessor.YAMLEq",
+		Path:         assessorPath,
+		TypeModifier: func(originalType string) string { return originalType },
+	},
+	stdFuncRegexp: {
+		Name:         "assessor.Regexp",
+		Path:         assessorPath,
+		TypeModifier: func(originalType string) string { return originalType },
+	},
+	stdFuncHasPrefix: {
+		Name:         "assessor.HasPrefix",
+		Path:         assessorPath,
+		TypeModifier: func(originalType string) string { return originalType },
+	},
+	stdFuncContains: {
+		Name:         "assessor.Contains",
+		Path:         assessorPath,
+		TypeModifier: func(originalType string) string { return originalType },
+	},
+	stdFuncSQLEq: {
+		Name:         "assessor.SQLEq",
+		Path:         assessorPath,
+		TypeModifier: func(originalType string) string { return originalType },
+	},
+	stdFuncInDelta: {
+		Name:         "assessor.InDelta",
+		Path:         assessorPath,
+		TypeModifier: func(originalType string) string { return originalType },
+	},
+	stdFuncInEpsilon: {
+		Name:         "assessor.InEpsilon",
+		Path:         assessorPath,
+		TypeModifier: func(originalType string) string { return originalType },
+	},
+	stdFuncWithinDuration: {
+		Name:         "assessor.WithinDuration",
+		Path:         assessorPath,
+		TypeModifier: func(originalType string) string { return originalType },
+	},
+	stdFuncAfter: {
+		Name:         "assessor.After",
+		Path:         assessorPath,
+		TypeModifier: func(originalType string) string { return originalType },
+	},
+	stdFuncBefore: {
+		Name:         "assessor.Before",
+		Path:         assessorPath,
+		TypeModifier: func(originalType string) string { return originalType },
+	},
+	stdFuncBetween: {
+		Name:         "assessor.Between",
+		Path:         assessorPath,
+		TypeModifier: func(string) string { return "" },
+		ArgsRequired: true,
+	},
+	stdFuncSuperset: {
+		Name:         "assessor.Superset",
+		Path:         assessorPath,
+		TypeModifier: func(originalType string) string { return originalType },
+		EqualOptions: true,
+	},
+	stdFuncSubset: {
+		Name:         "assessor.Subset",
+		Path:         assessorPath,
+		TypeModifier: func(originalType string) string { return originalType },
+		EqualOptions: true,
+	},
+	stdFuncMapContains: {
+		Name:         "assessor.MapContains",
+		Path:         assessorPath,
+		TypeModifier: func(originalType string) string { return originalType },
+		EqualOptions: true,
+	},
+	stdFuncUnorderedEqual: {
+		Name:         "assessor.UnorderedEqual",
+		Path:         assessorPath,
+		TypeModifier: func(originalType string) string { return originalType },
+		EqualOptions: true,
+	},
+	stdFuncLen: {
+		Name:         "assessor.Len",
+		Path:         assessorPath,
+		TypeModifier: func(string) string { return "" },
+		ArgsRequired: true,
+	},
+	StdFuncErrorIs: {
+		Name:         "assessor.ErrorIs",
+		Path:         assessorPath,
+		TypeModifier: func(originalType string) string { return originalType },
+	},
+	stdFuncErrorContains: {
+		Name:         "assessor.ErrorContains",
+		Path:         assessorPath,
+		TypeModifier: func(string) string { return "" },
+		ArgsRequired: true,
+	},
+	stdFuncContextHasDeadline: {
+		Name:         "assessor.ContextHasDeadline",
+		Path:         assessorPath,
+		TypeModifier: func(string) string { return "" },
+		Called:       true,
+	},
+	stdFuncContextNotCancelled: {
+		Name:         "assessor.ContextNotCancelled",
+		Path:         assessorPath,
+		TypeModifier: func(string) string { return "" },
+		Called:       true,
+	},
+	stdFuncContextValue: {
+		Name:         "assessor.ContextValue",
+		Path:         assessorPath,
+		TypeModifier: func(string) string { return "" },
+		Called:       true,
+	},
 }
 
-var errInvalidFieldOverwriterParams = errors.New("invalid field overwriter params")
+var (
+	errInvalidFieldOverwriterParams = errors.New("invalid field overwriter params")
+	errUnknownFieldShape            = errors.New("unknown field shape")
+	errInvalidAlias                 = errors.New("invalid matcher alias")
+	errInvalidFuncArgs              = errors.New("invalid matcher arguments")
+	errInvalidRule                  = errors.New("invalid field overwriter rule")
+)
+
+// assessorAlias is the shorthand of the path of the assessor package in params.
+const assessorAlias = "assessor"
+
+// Alias describes a matcher registered under a short name, Shape is one of the shapes accepted after a colon
+// in params and defaults to same.
+type Alias struct {
+	Path  string
+	Func  string
+	Shape string
+}
+
+// aliases keeps matchers registered in config by lower-cased names, as config keys are case-insensitive.
+type aliases map[string]stdFuncDescription
+
+func newAliases(custom map[string]Alias) (aliases, error) {
+	res := make(aliases, len(custom))
+	for name, alias := range custom {
+		if alias.Func == "" {
+			return nil, fmt.Errorf("%w: %s", errInvalidAlias, name)
+		}
+
+		shape := alias.Shape
+		if shape == "" {
+			shape = shapeSame
+		}
+		typeModifier, err := newTypeModifier(shape)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %w", errInvalidAlias, name, err)
+		}
+
+		funcName := alias.Func
+		if pkgAlias := getAliasFromPath(alias.Path); pkgAlias != "" {
+			funcName = pkgAlias + "." + funcName
+		}
+		res[strings.ToLower(name)] = stdFuncDescription{Name: funcName, Path: alias.Path, TypeModifier: typeModifier}
+	}
+
+	return res, nil
+}
+
+// get returns the matcher registered under funcName, aliases from config take precedence over standard ones.
+func (a aliases) get(funcPath, funcName string) *stdFuncDescription {
+	if funcPath != "" {
+		return nil
+	}
+
+	if f, ok := a[strings.ToLower(funcName)]; ok {
+		return &f
+	}
+	if f, ok := stdFunctions[funcName]; ok {
+		return &f
+	}
+
+	return nil
+}
+
+const (
+	shapeSame      = "same"
+	shapeSlice     = "slice"
+	shapePredicate = "predicate"
+	shapeNone      = "none"
+)
+
+// newTypeModifier returns how the matcher changes the type of the field: it keeps the type, makes a slice or
+// a predicate of it, removes the field or builds the type from a template getting the original type.
+func newTypeModifier(shape string) (func(originalType string) string, error) {
+	switch shape {
+	case shapeSame:
+		return func(originalType string) string { return originalType }, nil
+	case shapeSlice:
+		return func(originalType string) string { return "[]" + originalType }, nil
+	case shapePredicate:
+		return func(originalType string) string { return "func(" + originalType + ") bool" }, nil
+	case shapeNone:
+		return func(string) string { return "" }, nil
+	}
+
+	if !strings.Contains(shape, "{{") {
+		return nil, fmt.Errorf("%w: %s", errUnknownFieldShape, shape)
+	}
+
+	tmpl, err := template.New("shape").Parse(shape)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errUnknownFieldShape, err)
+	}
+
+	return func(originalType string) string {
+		var buf strings.Builder
+		if err := tmpl.Execute(&buf, originalType); err != nil {
+			return originalType
+		}
+
+		return buf.String()
+	}, nil
+}
 
 type Overwriter interface {
 	GetFuncPath() string
 	GetFuncName() string
+	GetFuncArgs() []string
 	ModifyType(original string) string
+	TakesEqualOptions() bool
 }
 
 func getAliasFromPath(path string) string {
@@ -69,61 +352,303 @@ func tryParseUnsignedInt(s string) int {
 }
 
 type FieldOverwriter struct {
-	methodName   string
-	fieldName    *string
-	fieldIndex   *int
-	funcPath     string
-	funcName     string
-	typeModifier func(originalType string) string // currently supported on std functions
+	methodName    string
+	methodPattern string
+	methodRegexp  *regexp.Regexp
+	fieldName     *string
+	fieldIndex    *int
+	fieldPattern  string
+	fieldType     string
+	fieldGoType   types.Type // fieldType resolved in the package of the interface, nil when it can't be resolved
+	fieldContext  bool
+	funcPath      string
+	funcName      string
+	funcArgs      []string
+	typeModifier  func(originalType string) string // set by std functions, aliases and shapes
+	equalOptions  bool
+	fallback      bool
+}
+
+// cutShape splits the matcher from its shape at the first colon outside of arguments of the matcher.
+func cutShape(function string) (before, after string, found bool) { //nolint:nonamedreturns
+	var depth int
+	var quote rune
+	var escaped bool
+	for i, r := range function {
+		switch {
+		case escaped:
+			escaped = false
+		case quote != 0 && r == '\\' && quote != '`':
+			escaped = true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'' || r == '`':
+			quote = r
+		case r == '(':
+			depth++
+		case r == ')':
+			depth--
+		case r == ':' && depth == 0:
+			return function[:i], function[i+1:], true
+		}
+	}
+
+	return function, "", false
+}
+
+// parseFuncArgs parses literal arguments of the matcher written in parentheses.
+func parseFuncArgs(args string) ([]string, error) {
+	expr, err := goparser.ParseExpr("f" + args)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidFuncArgs, err)
+	}
+
+	call, ok := expr.(*ast.CallExpr)
+	if !ok || call.Ellipsis.IsValid() {
+		return nil, fmt.Errorf("%w: %s", errInvalidFuncArgs, args)
+	}
+
+	res := make([]string, 0, len(call.Args))
+	for _, arg := range call.Args {
+		if !isLiteral(arg) {
+			return nil, fmt.Errorf("%w: %s is not a literal", errInvalidFuncArgs, types.ExprString(arg))
+		}
+
+		res = append(res, types.ExprString(arg))
+	}
+
+	return res, nil
+}
+
+// timeUnits are constants of the time package usable in arguments, e.g. 5*time.Second.
+var timeUnits = map[string]bool{ //nolint:gochecknoglobals
+	"Nanosecond": true, "Microsecond": true, "Millisecond": true, "Second": true, "Minute": true, "Hour": true,
+}
+
+// isLiteral reports whether the argument is a literal, a unit of time or arithmetic of them.
+func isLiteral(expr ast.Expr) bool {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		return true
+	case *ast.Ident:
+		return e.Name == "true" || e.Name == "false" || e.Name == "nil"
+	case *ast.SelectorExpr:
+		pkg, ok := e.X.(*ast.Ident)
+
+		return ok && pkg.Name == "time" && timeUnits[e.Sel.Name]
+	case *ast.UnaryExpr:
+		return e.Op == token.SUB && isLiteral(e.X)
+	case *ast.BinaryExpr:
+		switch e.Op { //nolint:exhaustive
+		case token.ADD, token.SUB, token.MUL, token.QUO:
+			return isLiteral(e.X) && isLiteral(e.Y)
+		default:
+			return false
+		}
+	case *ast.ParenExpr:
+		return isLiteral(e.X)
+	default:
+		return false
+	}
 }
 
-func newFieldOverwriter(params string) (*FieldOverwriter, error) {
-	paramsParser := regexp.MustCompile(`^([a-zA-Z0-9]+)\.([a-zA-Z0-9]+)=((.+)\.)?([a-zA-Z0-9]+)$`)
-	match := paramsParser.FindStringSubmatch(params)
+// newFieldOverwriter parses params written as Method.param=path.Func with optional literal arguments
+// in parentheses and an optional :shape suffix, see newTypeModifier for shapes.
+func newFieldOverwriter(params string, aliases aliases) (*FieldOverwriter, error) {
+	selector, function, _ := strings.Cut(params, "=")
+	selectorParser := regexp.MustCompile(`^([a-zA-Z0-9]+)\.([a-zA-Z0-9]+)$`)
+	match := selectorParser.FindStringSubmatch(selector)
 	if len(match) == 0 {
 		return nil, errInvalidFieldOverwriterParams
 	}
 
-	funcPath := match[4]
-	funcName := match[5]
+	res, err := newMatcher(function, aliases)
+	if err != nil {
+		return nil, err
+	}
+
+	res.methodName = match[1]
+	if index := tryParseUnsignedInt(match[2]); index >= 0 {
+		res.fieldIndex = &index
+	} else {
+		res.fieldName = &match[2]
+	}
+
+	return res, nil
+}
+
+// newMatcher parses the matcher part of params, the result matches no params yet.
+func newMatcher(function string, aliases aliases) (*FieldOverwriter, error) {
+	function, shape, withShape := cutShape(function)
+
+	var funcArgs []string
+	if i := strings.Index(function, "("); i >= 0 {
+		var err error
+		if funcArgs, err = parseFuncArgs(function[i:]); err != nil {
+			return nil, err
+		}
+		function = function[:i]
+	}
+
+	funcParser := regexp.MustCompile(`^((.+)\.)?([a-zA-Z0-9]+)$`)
+	match := funcParser.FindStringSubmatch(function)
+	if len(match) == 0 {
+		return nil, errInvalidFieldOverwriterParams
+	}
+
+	funcPath := match[2]
+	if funcPath == assessorAlias {
+		funcPath = assessorPath
+	}
+	funcName := match[3]
 	if alias := getAliasFromPath(funcPath); alias != "" {
 		funcName = alias + "." + funcName
 	}
 
 	typeModifier := func(originalType string) string { return originalType }
-	if stdFunc := getStdFunction(funcPath, funcName); stdFunc != nil {
+	var equalOptions bool
+	if stdFunc := aliases.get(funcPath, funcName); stdFunc != nil {
 		funcPath = stdFunc.Path
 		funcName = stdFunc.Name
 		typeModifier = stdFunc.TypeModifier
+		equalOptions = stdFunc.EqualOptions && funcArgs == nil
+		if stdFunc.Called && funcArgs == nil {
+			funcArgs = []string{}
+		}
+		if stdFunc.ArgsRequired && len(funcArgs) == 0 {
+			return nil, fmt.Errorf("%w: %s requires arguments", errInvalidFuncArgs, funcName)
+		}
 	}
-	var fieldIndex *int
-	fieldName := &match[2]
-	if parsedFieldName := tryParseUnsignedInt(*fieldName); parsedFieldName >= 0 {
-		fieldIndex = &parsedFieldName
-		fieldName = nil
+	if withShape {
+		var err error
+		if typeModifier, err = newTypeModifier(shape); err != nil {
+			return nil, err
+		}
 	}
 
 	return &FieldOverwriter{
-		methodName:   match[1],
-		fieldName:    fieldName,
-		fieldIndex:   fieldIndex,
 		funcPath:     funcPath,
 		funcName:     funcName,
+		funcArgs:     funcArgs,
 		typeModifier: typeModifier,
+		equalOptions: equalOptions,
 	}, nil
 }
 
-func getStdFunction(funcPath, funcName string) *stdFuncDescription {
-	if funcPath != "" {
-		return nil
+// Rule selects params the matcher is applied to, every set selector has to match the param.
+type Rule struct {
+	Method       string // name or glob of the method
+	MethodRegexp string
+	Param        string // name, index or glob of the param
+	Type         string // type of the param with full package paths, e.g. []github.com/acme/model.ID
+	Context      bool   // selects params implementing context.Context
+	Matcher      string // matcher written as in params, e.g. elementsMatch or assessor.InDelta(0.01)
+	Fallback     bool   // applies only to params selected by no other params or rules
+}
+
+func isGlob(s string) bool {
+	return strings.ContainsAny(s, "*?[")
+}
+
+func newRuleOverwriter(rule Rule, aliases aliases) (*FieldOverwriter, error) {
+	if rule.Matcher == "" || rule == (Rule{Matcher: rule.Matcher, Fallback: rule.Fallback}) {
+		return nil, errInvalidRule
 	}
 
-	stdFunc, ok := stdFunctions[funcName]
-	if !ok {
-		return nil
+	res, err := newMatcher(rule.Matcher, aliases)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pattern := range []string{rule.Method, rule.Param} {
+		if _, err = path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("%w: %w", errInvalidRule, err)
+		}
 	}
 
-	return &stdFunc
+	if isGlob(rule.Method) {
+		res.methodPattern = rule.Method
+	} else {
+		res.methodName = rule.Method
+	}
+	if rule.MethodRegexp != "" {
+		if res.methodRegexp, err = regexp.Compile(rule.MethodRegexp); err != nil {
+			return nil, fmt.Errorf("%w: %w", errInvalidRule, err)
+		}
+	}
+
+	switch index := tryParseUnsignedInt(rule.Param); {
+	case index >= 0:
+		res.fieldIndex = &index
+	case isGlob(rule.Param):
+		res.fieldPattern = rule.Param
+	case rule.Param != "":
+		res.fieldName = &rule.Param
+	}
+	res.fieldType = rule.Type
+	res.fieldContext = rule.Context
+	res.fallback = rule.Fallback
+
+	return res, nil
+}
+
+func (f *FieldOverwriter) matches(methodName, paramName string, index int, paramType types.Type, isContext bool) bool {
+	switch {
+	case f.methodName != "" && f.methodName != methodName,
+		f.methodPattern != "" && !globMatch(f.methodPattern, methodName),
+		f.methodRegexp != nil && !f.methodRegexp.MatchString(methodName),
+		f.fieldName != nil && *f.fieldName != paramName,
+		f.fieldIndex != nil && *f.fieldIndex != index,
+		f.fieldPattern != "" && !globMatch(f.fieldPattern, paramName),
+		f.fieldType != "" && !f.matchesType(paramType),
+		f.fieldContext && !isContext:
+		return false
+	default:
+		return true
+	}
+}
+
+// matchesType compares types by identity, so aliases match the types they stand for. Types which can't be
+// resolved are compared as written.
+func (f *FieldOverwriter) matchesType(paramType types.Type) bool {
+	switch {
+	case paramType == nil:
+		return false
+	case f.fieldGoType != nil:
+		return types.Identical(f.fieldGoType, paramType)
+	default:
+		return f.fieldType == types.TypeString(paramType, nil)
+	}
+}
+
+func globMatch(pattern, name string) bool {
+	matched, _ := path.Match(pattern, name)
+
+	return matched
+}
+
+// specificity orders overwriters matching the same param: a method given by name beats patterns of methods,
+// then a param given by name or index beats its type, which beats patterns of param names.
+func (f *FieldOverwriter) specificity() int {
+	var method, param int
+	switch {
+	case f.methodName != "":
+		method = 2 //nolint:mnd
+	case f.methodPattern != "" || f.methodRegexp != nil:
+		method = 1
+	}
+	switch {
+	case f.fieldName != nil || f.fieldIndex != nil:
+		param = 3 //nolint:mnd
+	case f.fieldType != "" || f.fieldContext:
+		param = 2 //nolint:mnd
+	case f.fieldPattern != "":
+		param = 1
+	}
+
+	return method*4 + param //nolint:mnd
 }
 
 func (f *FieldOverwriter) GetMethodName() string             { return f.methodName }
@@ -131,33 +656,75 @@ func (f *FieldOverwriter) GetFieldName() *string             { return f.fieldNam
 func (f *FieldOverwriter) GetFieldIndex() *int               { return f.fieldIndex }
 func (f *FieldOverwriter) GetFuncPath() string               { return f.funcPath }
 func (f *FieldOverwriter) GetFuncName() string               { return f.funcName }
+func (f *FieldOverwriter) GetFuncArgs() []string             { return f.funcArgs }
 func (f *FieldOverwriter) ModifyType(original string) string { return f.typeModifier(original) }
 
+// TakesEqualOptions tells whether options of assessor.Equal can be passed to the matcher after the field.
+func (f *FieldOverwriter) TakesEqualOptions() bool { return f.equalOptions }
+
 type Storage struct {
 	overwriters []FieldOverwriter
+	// pkg is the package of the interface, context.Context is looked up among its imports
+	pkg *types.Package
 }
 
-func NewStorage(overwritersParams []string) (*Storage, error) {
-	overwriters := make([]FieldOverwriter, 0, len(overwritersParams))
+// NewStorage creates overwriters from params written as Method.param=matcher and from rules, params go first
+// and win over rules of the same specificity. Types of rules are resolved among packages imported by pkg.
+func NewStorage(
+	overwritersParams []string, rules []Rule, customAliases map[string]Alias, pkg *types.Package,
+) (*Storage, error) {
+	aliases, err := newAliases(customAliases)
+	if err != nil {
+		return nil, err
+	}
+
+	overwriters := make([]FieldOverwriter, 0, len(overwritersParams)+len(rules))
 	for _, param := range overwritersParams {
-		overwriter, err := newFieldOverwriter(param)
+		overwriter, err := newFieldOverwriter(param, aliases)
+		if err != nil {
+			return nil, err
+		}
+
+		overwriters = append(overwriters, *overwriter)
+	}
+	for _, rule := range rules {
+		overwriter, err := newRuleOverwriter(rule, aliases)
 		if err != nil {
 			return nil, err
 		}
+		if rule.Type != "" {
+			overwriter.fieldGoType = parser.ResolveType(pkg, rule.Type)
+		}
 
 		overwriters = append(overwriters, *overwriter)
 	}
 
-	return &Storage{overwriters: overwriters}, nil
+	return &Storage{overwriters: overwriters, pkg: pkg}, nil
 }
 
-func (s *Storage) Get(methodName, paramName string, index int) Overwriter {
-	for _, overwriter := range s.overwriters {
-		if methodName == overwriter.GetMethodName() && ((overwriter.GetFieldName() != nil && paramName == *overwriter.GetFieldName()) ||
-			(overwriter.GetFieldIndex() != nil && index == *overwriter.GetFieldIndex())) {
-			return &overwriter
+// Get returns the most specific overwriter of the param, the first one among equally specific overwriters.
+// Fallback rules are only checked when no other overwriter selects the param.
+func (s *Storage) Get(methodName, paramName string, index int, paramType types.Type) Overwriter {
+	isContext := paramType != nil && parser.IsContext(s.pkg, paramType)
+
+	var res *FieldOverwriter
+	for _, fallback := range []bool{false, true} {
+		for i := range s.overwriters {
+			overwriter := &s.overwriters[i]
+			if overwriter.fallback != fallback || !overwriter.matches(methodName, paramName, index, paramType, isContext) {
+				continue
+			}
+			if res == nil || overwriter.specificity() > res.specificity() {
+				res = overwriter
+			}
+		}
+		if res != nil {
+			break
 		}
 	}
+	if res == nil {
+		return nil
+	}
 
-	return nil
+	return res
 }
@@ -1,6 +1,7 @@
 package fieldoverwriter //nolint:testpackage
 
 import (
+	"go/types"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -76,8 +77,140 @@ func Test_NewFieldOverwriter(t *testing.T) { //nolint:funlen
 				fieldName:  Link("X"),
 				funcPath:   "github.com/xgamtx/go-mockery-descriptor/pkg/assessor",
 				funcName:   "assessor.OneOf",
+
+				equalOptions: true,
+			},
+		},
+		{
+			name:   "OK, standard function with arguments",
+			params: "SetX.X=elementsMatch(\"a\")",
+
+			want: &FieldOverwriter{
+				methodName: "SetX",
+				fieldName:  Link("X"),
+				funcPath:   "github.com/xgamtx/go-mockery-descriptor/pkg/assessor",
+				funcName:   "assessor.ElementsMatch",
+				funcArgs:   []string{`"a"`},
 			},
 		},
+		{
+			name:   "unknown shape",
+			params: "SetX.X=github.com/acme/testutil.Contains:list",
+
+			wantErrMsg: "unknown field shape: list",
+		},
+		{
+			name:   "OK, with shape",
+			params: "SetX.X=github.com/acme/testutil.Contains:slice",
+
+			want: &FieldOverwriter{
+				methodName: "SetX",
+				fieldName:  Link("X"),
+				funcPath:   "github.com/acme/testutil",
+				funcName:   "testutil.Contains",
+			},
+		},
+		{
+			name:   "OK, assessor shorthand",
+			params: "Query.sql=assessor.Regexp",
+
+			want: &FieldOverwriter{
+				methodName: "Query",
+				fieldName:  Link("sql"),
+				funcPath:   "github.com/xgamtx/go-mockery-descriptor/pkg/assessor",
+				funcName:   "assessor.Regexp",
+			},
+		},
+		{
+			name:   "OK, with arguments",
+			params: `Save.user=assessor.IgnoreFields("CreatedAt", "ID")`,
+
+			want: &FieldOverwriter{
+				methodName: "Save",
+				fieldName:  Link("user"),
+				funcPath:   "github.com/xgamtx/go-mockery-descriptor/pkg/assessor",
+				funcName:   "assessor.IgnoreFields",
+				funcArgs:   []string{`"CreatedAt"`, `"ID"`},
+			},
+		},
+		{
+			name:   "OK, with arguments and shape",
+			params: `Price.amount=github.com/acme/testutil.InRange(-0.5, 1e3, "a:b)"):slice`,
+
+			want: &FieldOverwriter{
+				methodName: "Price",
+				fieldName:  Link("amount"),
+				funcPath:   "github.com/acme/testutil",
+				funcName:   "testutil.InRange",
+				funcArgs:   []string{"-0.5", "1e3", `"a:b)"`},
+			},
+		},
+		{
+			name:   "OK, called without arguments",
+			params: "Ping.0=github.com/acme/testutil.NotEmpty():none",
+
+			want: &FieldOverwriter{
+				methodName: "Ping",
+				fieldIndex: Link(0),
+				funcPath:   "github.com/acme/testutil",
+				funcName:   "testutil.NotEmpty",
+				funcArgs:   []string{},
+			},
+		},
+		{
+			name:   "OK, with duration argument",
+			params: "Save.at=withinDuration(5*time.Second + 500*time.Millisecond)",
+
+			want: &FieldOverwriter{
+				methodName: "Save",
+				fieldName:  Link("at"),
+				funcPath:   "github.com/xgamtx/go-mockery-descriptor/pkg/assessor",
+				funcName:   "assessor.WithinDuration",
+				funcArgs:   []string{"5 * time.Second + 500 * time.Millisecond"},
+			},
+		},
+		{
+			name:   "not a time unit",
+			params: "Save.at=withinDuration(time.Now)",
+
+			wantErrMsg: "invalid matcher arguments: time.Now is not a literal",
+		},
+		{
+			name:   "not literal argument",
+			params: "Price.amount=assessor.InDelta(delta)",
+
+			wantErrMsg: "invalid matcher arguments: delta is not a literal",
+		},
+		{
+			name:   "between without arguments",
+			params: "Retry.attempt=between",
+
+			wantErrMsg: "invalid matcher arguments: assessor.Between requires arguments",
+		},
+		{
+			name:   "between with empty arguments",
+			params: "Retry.attempt=between()",
+
+			wantErrMsg: "invalid matcher arguments: assessor.Between requires arguments",
+		},
+		{
+			name:   "len without arguments",
+			params: "Save.rows=len",
+
+			wantErrMsg: "invalid matcher arguments: assessor.Len requires arguments",
+		},
+		{
+			name:   "errorContains without arguments",
+			params: "Jobs.err=errorContains",
+
+			wantErrMsg: "invalid matcher arguments: assessor.ErrorContains requires arguments",
+		},
+		{
+			name:   "unbalanced arguments",
+			params: "Price.amount=assessor.InDelta(0.01",
+
+			wantErrMsg: "invalid matcher arguments",
+		},
 		{
 			name:   "OK, with param index",
 			params: "SetX.0=oneOf",
@@ -87,6 +220,8 @@ func Test_NewFieldOverwriter(t *testing.T) { //nolint:funlen
 				fieldIndex: Link(0),
 				funcPath:   "github.com/xgamtx/go-mockery-descriptor/pkg/assessor",
 				funcName:   "assessor.OneOf",
+
+				equalOptions: true,
 			},
 		},
 	}
@@ -94,13 +229,13 @@ func Test_NewFieldOverwriter(t *testing.T) { //nolint:funlen
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := newFieldOverwriter(tt.params)
+			got, err := newFieldOverwriter(tt.params, nil)
 			if got != nil {
 				got.typeModifier = nil // validate field in another test
 			}
 			assert.Equal(t, tt.want, got)
 			if tt.wantErrMsg != "" {
-				assert.Error(t, err, tt.wantErrMsg)
+				assert.ErrorContains(t, err, tt.wantErrMsg)
 			} else {
 				assert.NoError(t, err)
 			}
@@ -143,12 +278,100 @@ func Test_NewFieldOverwriter_typeModifier(t *testing.T) {
 
 			wantType: "[]bool",
 		},
+		{
+			name: "OK, same shape",
+
+			params:       "SetX.X=github.com/acme/testutil.Equal:same",
+			originalType: "bool",
+
+			wantType: "bool",
+		},
+		{
+			name: "OK, slice shape",
+
+			params:       "SetX.X=github.com/acme/testutil.Contains:slice",
+			originalType: "string",
+
+			wantType: "[]string",
+		},
+		{
+			name: "OK, predicate shape",
+
+			params:       "SetX.X=github.com/acme/testutil.Match:predicate",
+			originalType: "string",
+
+			wantType: "func(string) bool",
+		},
+		{
+			name: "OK, no field",
+
+			params:       "SetX.X=github.com/acme/testutil.AnyID:none",
+			originalType: "string",
+
+			wantType: "",
+		},
+		{
+			name: "OK, type template",
+
+			params:       "SetX.X=github.com/acme/testutil.KeysOf:map[{{ . }}]struct{}",
+			originalType: "string",
+
+			wantType: "map[string]struct{}",
+		},
+		{
+			name: "OK, fieldsMatch keeps the type",
+
+			params:       `Save.row=fieldsMatch("Name", "Address.City")`,
+			originalType: "Row",
+
+			wantType: "Row",
+		},
+		{
+			name: "OK, sqlEq keeps the type",
+
+			params:       "Query.sql=sqlEq",
+			originalType: "string",
+
+			wantType: "string",
+		},
+		{
+			name: "OK, superset keeps the type",
+
+			params:       "AddTags.tags=superset",
+			originalType: "[]string",
+
+			wantType: "[]string",
+		},
+		{
+			name: "OK, len has no field",
+
+			params:       "AddTags.tags=len(2)",
+			originalType: "[]string",
+
+			wantType: "",
+		},
+		{
+			name: "OK, errorContains has no field",
+
+			params:       `MarkFailed.err=errorContains("timeout")`,
+			originalType: "error",
+
+			wantType: "",
+		},
+		{
+			name: "OK, shape overrides standard function",
+
+			params:       "SetX.X=oneOf:same",
+			originalType: "bool",
+
+			wantType: "bool",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := newFieldOverwriter(tt.params)
+			got, err := newFieldOverwriter(tt.params, nil)
 			require.NotNil(t, got)
 			require.NoError(t, err)
 
@@ -157,4 +380,341 @@ func Test_NewFieldOverwriter_typeModifier(t *testing.T) {
 	}
 }
 
+func TestNewStorage_aliases(t *testing.T) {
+	t.Parallel()
+
+	storage, err := NewStorage([]string{"SetX.X=containsAll", "SetX.Y=oneOf", "SetX.Z=eq"}, nil, map[string]Alias{
+		"containsall": {Path: "github.com/acme/testutil", Func: "ContainsAll", Shape: "slice"},
+		"oneof":       {Path: "github.com/acme/testutil/v2", Func: "AnyOf", Shape: "slice"},
+		"eq":          {Func: "Equal"},
+	}, nil)
+	require.NoError(t, err)
+
+	got := storage.Get("SetX", "X", 0, nil)
+	require.NotNil(t, got)
+	assert.Equal(t, "github.com/acme/testutil", got.GetFuncPath())
+	assert.Equal(t, "testutil.ContainsAll", got.GetFuncName())
+	assert.Equal(t, "[]string", got.ModifyType("string"))
+
+	got = storage.Get("SetX", "Y", 1, nil)
+	require.NotNil(t, got)
+	assert.Equal(t, "testutil.AnyOf", got.GetFuncName())
+
+	got = storage.Get("SetX", "Z", 2, nil)
+	require.NotNil(t, got)
+	assert.Empty(t, got.GetFuncPath())
+	assert.Equal(t, "Equal", got.GetFuncName())
+	assert.Equal(t, "string", got.ModifyType("string"))
+}
+
+func TestNewStorage_invalidAlias(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewStorage(nil, nil, map[string]Alias{"eq": {Path: "github.com/acme/testutil"}}, nil)
+	require.ErrorIs(t, err, errInvalidAlias)
+
+	_, err = NewStorage(nil, nil, map[string]Alias{"eq": {Func: "Equal", Shape: "list"}}, nil)
+	require.ErrorIs(t, err, errUnknownFieldShape)
+}
+
 func Link[T any](val T) *T { return &val }
+
+func TestStorage_Get_rules(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	idsType := types.NewSlice(types.NewNamed(
+		types.NewTypeName(0, types.NewPackage("github.com/acme/model", "model"), "ID", nil), types.Typ[types.String], nil,
+	))
+	pkg, ctxType, jobType := newContextPackage()
+
+	tests := []struct {
+		name string
+
+		params []string
+		rules  []Rule
+
+		methodName string
+		paramName  string
+		index      int
+		paramType  types.Type
+
+		wantFuncName string
+		wantFuncArgs []string
+	}{
+		{
+			name: "no rules",
+
+			methodName: "Get",
+			paramName:  "ids",
+			paramType:  idsType,
+		},
+		{
+			name: "OK, by type",
+
+			rules: []Rule{{Type: "[]github.com/acme/model.ID", Matcher: "elementsMatch"}},
+
+			methodName: "Get",
+			paramName:  "ids",
+			paramType:  idsType,
+
+			wantFuncName: "assessor.ElementsMatch",
+		},
+		{
+			name: "type does not match",
+
+			rules: []Rule{{Type: "[]string", Matcher: "elementsMatch"}},
+
+			methodName: "Get",
+			paramName:  "ids",
+			paramType:  idsType,
+		},
+		{
+			name: "OK, by method glob and param glob",
+
+			rules: []Rule{{Method: "List*", Param: "*ID", Matcher: "oneOf"}},
+
+			methodName: "ListUsers",
+			paramName:  "groupID",
+			index:      1,
+
+			wantFuncName: "assessor.OneOf",
+		},
+		{
+			name: "method regexp does not match",
+
+			rules: []Rule{{MethodRegexp: "^(Get|List)", Param: "id", Matcher: "oneOf"}},
+
+			methodName: "CreateUser",
+			paramName:  "id",
+		},
+		{
+			name: "name beats type",
+
+			rules: []Rule{
+				{Type: "[]github.com/acme/model.ID", Matcher: "elementsMatch"},
+				{Param: "ids", Matcher: "any"},
+			},
+
+			methodName: "Get",
+			paramName:  "ids",
+			paramType:  idsType,
+
+			wantFuncName: "mock.Anything",
+		},
+		{
+			name: "type beats glob",
+
+			rules: []Rule{
+				{Param: "id*", Matcher: "any"},
+				{Type: "[]github.com/acme/model.ID", Matcher: "elementsMatch"},
+			},
+
+			methodName: "Get",
+			paramName:  "ids",
+			paramType:  idsType,
+
+			wantFuncName: "assessor.ElementsMatch",
+		},
+		{
+			name: "exact method beats method pattern",
+
+			rules: []Rule{
+				{Method: "*", Param: "ids", Matcher: "any"},
+				{Method: "Get", Type: "[]github.com/acme/model.ID", Matcher: "elementsMatch"},
+			},
+
+			methodName: "Get",
+			paramName:  "ids",
+			paramType:  idsType,
+
+			wantFuncName: "assessor.ElementsMatch",
+		},
+		{
+			name: "params win over rules of the same specificity",
+
+			params: []string{"Get.ids=any"},
+			rules:  []Rule{{Method: "Get", Param: "ids", Matcher: "elementsMatch"}},
+
+			methodName: "Get",
+			paramName:  "ids",
+			paramType:  idsType,
+
+			wantFuncName: "mock.Anything",
+		},
+		{
+			name: "OK, by context, constructor is called without arguments",
+
+			rules: []Rule{{Context: true, Matcher: "contextNotCancelled"}},
+
+			methodName: "Get",
+			paramName:  "ctx",
+			paramType:  ctxType,
+
+			wantFuncName: "assessor.ContextNotCancelled",
+			wantFuncArgs: []string{},
+		},
+		{
+			name: "not a context",
+
+			rules: []Rule{{Context: true, Matcher: "contextNotCancelled"}},
+
+			methodName: "Get",
+			paramName:  "ids",
+			paramType:  idsType,
+		},
+		{
+			name: "OK, fallback rule",
+
+			rules: []Rule{{Type: "[]github.com/acme/model.ID", Matcher: "elementsMatch", Fallback: true}},
+
+			methodName: "Get",
+			paramName:  "ids",
+			paramType:  idsType,
+
+			wantFuncName: "assessor.ElementsMatch",
+		},
+		{
+			name: "OK, rule by param pattern beats more specific fallback rule",
+
+			rules: []Rule{
+				{Type: "[]github.com/acme/model.ID", Matcher: "elementsMatch", Fallback: true},
+				{Param: "i*", Matcher: "oneOf"},
+			},
+
+			methodName: "Get",
+			paramName:  "ids",
+			paramType:  idsType,
+
+			wantFuncName: "assessor.OneOf",
+		},
+		{
+			name: "only Done is not a context",
+
+			rules: []Rule{{Context: true, Matcher: "contextNotCancelled"}},
+
+			methodName: "Get",
+			paramName:  "job",
+			paramType:  jobType,
+		},
+		{
+			name: "OK, context matcher of the method beats one of the interface",
+
+			rules: []Rule{
+				{Context: true, Matcher: "contextNotCancelled"},
+				{Method: "Get", Context: true, Matcher: `contextValue("tenant", "acme")`},
+			},
+
+			methodName: "Get",
+			paramName:  "ctx",
+			paramType:  ctxType,
+
+			wantFuncName: "assessor.ContextValue",
+			wantFuncArgs: []string{`"tenant"`, `"acme"`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			storage, err := NewStorage(tt.params, tt.rules, nil, pkg)
+			require.NoError(t, err)
+
+			got := storage.Get(tt.methodName, tt.paramName, tt.index, tt.paramType)
+			if tt.wantFuncName == "" {
+				assert.Nil(t, got)
+
+				return
+			}
+			require.NotNil(t, got)
+			assert.Equal(t, tt.wantFuncName, got.GetFuncName())
+			assert.Equal(t, tt.wantFuncArgs, got.GetFuncArgs())
+		})
+	}
+}
+
+// newContextPackage returns a package importing context, a context.Context and a type with its Done method only.
+func newContextPackage() (pkg *types.Package, ctxType, jobType types.Type) { //nolint:nonamedreturns
+	contextPkg := types.NewPackage("context", "context")
+	newMethod := func(name string, result types.Type) *types.Func {
+		return types.NewFunc(0, contextPkg, name, types.NewSignatureType(
+			nil, nil, nil, nil, types.NewTuple(types.NewParam(0, contextPkg, "", result)), false,
+		))
+	}
+	done := newMethod("Done", types.NewChan(types.RecvOnly, types.NewStruct(nil, nil)))
+	errMethod := newMethod("Err", types.Universe.Lookup("error").Type())
+
+	ctxName := types.NewTypeName(0, contextPkg, "Context", nil)
+	ctxType = types.NewNamed(ctxName, types.NewInterfaceType([]*types.Func{done, errMethod}, nil).Complete(), nil)
+	contextPkg.Scope().Insert(ctxName)
+
+	pkg = types.NewPackage("github.com/acme/app", "app")
+	pkg.SetImports([]*types.Package{contextPkg})
+
+	return pkg, ctxType, types.NewInterfaceType([]*types.Func{done}, nil).Complete()
+}
+
+func TestNewStorage_invalidRule(t *testing.T) {
+	t.Parallel()
+
+	for _, rule := range []Rule{
+		{Param: "id"},
+		{Matcher: "any"},
+		{Method: "[", Matcher: "any"},
+		{MethodRegexp: "(", Matcher: "any"},
+		{Matcher: "any", Fallback: true},
+	} {
+		_, err := NewStorage(nil, []Rule{rule}, nil, nil)
+		require.ErrorIs(t, err, errInvalidRule)
+	}
+}
+
+func TestStorage_Get_resolvedRuleTypes(t *testing.T) {
+	t.Parallel()
+
+	model := types.NewPackage("github.com/acme/model", "model")
+	idName := types.NewTypeName(0, model, "ID", nil)
+	idType := types.NewNamed(idName, types.Typ[types.String], nil)
+	model.Scope().Insert(idName)
+	tagName := types.NewTypeName(0, model, "Tag", nil)
+	tagType := types.NewAlias(tagName, types.Typ[types.String])
+	model.Scope().Insert(tagName)
+	svc := types.NewPackage("github.com/acme/svc", "svc")
+	svc.SetImports([]*types.Package{model})
+	anyType, errorType := types.Universe.Lookup("any").Type(), types.Universe.Lookup("error").Type()
+
+	tests := []struct {
+		name string
+
+		ruleType  string
+		paramType types.Type
+
+		wantMatch bool
+	}{
+		{name: "named type", ruleType: "[]github.com/acme/model.ID", paramType: types.NewSlice(idType), wantMatch: true},
+		{name: "named type is not its underlying type", ruleType: "github.com/acme/model.ID", paramType: types.Typ[types.String]},
+		{name: "alias matches its type", ruleType: "github.com/acme/model.Tag", paramType: types.Typ[types.String], wantMatch: true},
+		{name: "type matches its alias", ruleType: "map[string]string", paramType: types.NewMap(tagType, tagType), wantMatch: true},
+		{name: "any matches empty interface", ruleType: "any", paramType: types.NewInterfaceType(nil, nil), wantMatch: true},
+		{name: "empty interface matches any", ruleType: "[]interface{}", paramType: types.NewSlice(anyType), wantMatch: true},
+		{name: "any is not error", ruleType: "any", paramType: errorType},
+		{
+			name: "pointer to array", ruleType: "*[2]github.com/acme/model.ID",
+			paramType: types.NewPointer(types.NewArray(idType, 2)), wantMatch: true,
+		},
+		{name: "channel direction", ruleType: "<-chan int", paramType: types.NewChan(types.SendRecv, types.Typ[types.Int])},
+		{name: "unresolved type compared as written", ruleType: "func() error", paramType: types.NewSignatureType(
+			nil, nil, nil, nil, types.NewTuple(types.NewParam(0, nil, "", errorType)), false,
+		), wantMatch: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			storage, err := NewStorage(nil, []Rule{{Type: tt.ruleType, Matcher: "any"}}, nil, svc)
+			require.NoError(t, err)
+
+			got := storage.Get("Get", "v", 0, tt.paramType)
+			assert.Equal(t, tt.wantMatch, got != nil)
+		})
+	}
+}
@@ -3,29 +3,44 @@ package generator
 import (
 	"bytes"
 	_ "embed"
+	"errors"
 	"fmt"
 	"go/ast"
 	"go/format"
+	"go/token"
+	"go/types"
+	"slices"
 	"strconv"
 	"strings"
 	"text/template"
 
 	"golang.org/x/tools/imports"
 
+	"github.com/xgamtx/go-mockery-descriptor/internal/anythingtypes"
 	"github.com/xgamtx/go-mockery-descriptor/internal/config"
 	"github.com/xgamtx/go-mockery-descriptor/internal/fieldoverwriter"
+	"github.com/xgamtx/go-mockery-descriptor/internal/outparams"
 	"github.com/xgamtx/go-mockery-descriptor/internal/parser"
 	"github.com/xgamtx/go-mockery-descriptor/internal/returnsrenamer"
 )
 
 const (
-	anyCtxConst = "anyCtx"
-	anyTxConst  = "anyTx"
+	assessorPath = "github.com/xgamtx/go-mockery-descriptor/pkg/assessor"
+)
+
+// durationType stands for time.Duration in fields added by the generator itself.
+var durationType = types.NewNamed( //nolint:gochecknoglobals
+	types.NewTypeName(token.NoPos, types.NewPackage("time", "time"), "Duration", nil), types.Typ[types.Int64], nil,
 )
 
 //go:embed mock.tmpl
 var tmplContent string
 
+var (
+	errDuplicateField = errors.New("duplicate call descriptor field")
+	errDuplicateType  = errors.New("duplicate generated type")
+)
+
 func exprToString(expr ast.Expr) string {
 	switch t := expr.(type) {
 	case *ast.Ident:
@@ -38,6 +53,10 @@ func exprToString(expr ast.Expr) string {
 		return "[]" + exprToString(t.Elt)
 	case *ast.MapType:
 		return "map[" + exprToString(t.Key) + "]" + exprToString(t.Value)
+	case *ast.Ellipsis:
+		return "[]" + exprToString(t.Elt)
+	case *ast.ChanType:
+		return "chan " + exprToString(t.Value)
 	case *ast.InterfaceType:
 		if t.Methods == nil || len(t.Methods.List) == 0 {
 			return "any"
@@ -51,109 +70,326 @@ func exprToString(expr ast.Expr) string {
 	}
 }
 
+type fieldView struct {
+	Name     string
+	Type     string
+	Tag      string
+	goType   types.Type
+	codeOnly bool // the field can be set only in Go code, fixtures skip it
+}
+
 type param interface {
-	GenerateField() string
+	GetField() *fieldView
 	GenerateAssessor(callerName string) string
+	GenerateRecord(callerName string) string
+	GetArgName() string
+	GetArgType() string
+	IsVariadic() bool
 	GetPathTypes() []string
+	getGoType() types.Type
 }
 
-type stdParamView struct {
+type argument struct {
 	name      string
-	paramType string
+	argType   string
+	goType    types.Type
 	pathTypes []string
+	// variadic marks the last param of a variadic method, argType is the slice of its elements
+	variadic bool
+}
+
+func newArgument(v *parser.Value, i int, pkg *types.Package) argument {
+	name := v.Name
+	if name == "" {
+		name = "p" + strconv.Itoa(i)
+	}
+
+	return argument{
+		name:      name,
+		argType:   typeString(v, pkg),
+		goType:    v.GoType,
+		pathTypes: v.PathTypes,
+		variadic:  v.Variadic,
+	}
+}
+
+// typeString returns the type of the value as the generated file refers to it,
+// types of pkg, the package of the interface, are not qualified.
+func typeString(v *parser.Value, pkg *types.Package) string {
+	if v.GoType == nil {
+		return exprToString(v.Type)
+	}
+
+	return types.TypeString(v.GoType, func(other *types.Package) string {
+		if other == pkg {
+			return ""
+		}
+
+		return other.Name()
+	})
 }
 
-type ctxParamView struct{}
+func (a *argument) GetArgName() string     { return a.name }
+func (a *argument) GetArgType() string     { return a.argType }
+func (a *argument) IsVariadic() bool       { return a.variadic }
+func (a *argument) GetPathTypes() []string { return a.pathTypes }
+func (a *argument) getGoType() types.Type  { return a.goType }
 
-func (v *ctxParamView) GenerateField() string          { return "" }
-func (v *ctxParamView) GenerateAssessor(string) string { return anyCtxConst }
-func (v *ctxParamView) GetPathTypes() []string         { return nil }
+// stdParamView describes a param matched by equality to its field, by assessor.Equal when equal is set.
+type stdParamView struct {
+	argument
+	equal            bool
+	withEqualOptions bool
+}
 
-type txParamView struct{}
+// anythingParamView describes a param of a type matched by anything, varName is the variable holding the matcher.
+type anythingParamView struct {
+	argument
+	varName string
+}
 
-func (v *txParamView) GenerateField() string          { return "" }
-func (v *txParamView) GenerateAssessor(string) string { return anyTxConst }
-func (v *txParamView) GetPathTypes() []string         { return nil }
+func (v *anythingParamView) GetField() *fieldView           { return nil }
+func (v *anythingParamView) GenerateAssessor(string) string { return v.varName }
+func (v *anythingParamView) GenerateRecord(string) string   { return "" }
 
 type customFunctionParamView struct {
+	argument
 	paramName string
 	paramType string
 	funcName  string
-	pathTypes []string
+	funcArgs  []string
+	// isAssessor marks matchers of pkg/assessor, they are passed to testify by the reporter
+	isAssessor bool
+	// takesEqualOptions marks matchers taking options of assessor.Equal, withEqualOptions passes them
+	takesEqualOptions bool
+	withEqualOptions  bool
 }
 
-func newCustomFunctionParamView(v *parser.Value, fieldOverwriter fieldoverwriter.Overwriter) *customFunctionParamView {
-	pathTypes := v.PathTypes
+func newCustomFunctionParamView(arg argument, fieldOverwriter fieldoverwriter.Overwriter) *customFunctionParamView {
 	if pathType := fieldOverwriter.GetFuncPath(); pathType != "" {
-		pathTypes = append(pathTypes, pathType)
+		arg.pathTypes = append(arg.pathTypes, pathType)
 	}
 
 	return &customFunctionParamView{
-		paramName: capitalize(v.Name),
-		paramType: fieldOverwriter.ModifyType(exprToString(v.Type)),
-		funcName:  fieldOverwriter.GetFuncName(),
-		pathTypes: pathTypes,
+		argument:   arg,
+		paramName:  capitalize(arg.name),
+		paramType:  fieldOverwriter.ModifyType(arg.argType),
+		funcName:   fieldOverwriter.GetFuncName(),
+		funcArgs:   fieldOverwriter.GetFuncArgs(),
+		isAssessor: fieldOverwriter.GetFuncPath() == assessorPath,
+
+		takesEqualOptions: fieldOverwriter.TakesEqualOptions(),
 	}
 }
 
-func (v *customFunctionParamView) GenerateField() string {
+func (v *customFunctionParamView) GetField() *fieldView {
 	if v.paramType == "" {
-		return ""
+		return nil
 	}
 
-	return v.paramName + " " + v.paramType
+	var goType types.Type
+	switch {
+	case v.goType == nil:
+	case v.paramType == v.argType:
+		goType = v.goType
+	case v.paramType == "[]"+v.argType:
+		goType = types.NewSlice(v.goType)
+	}
+
+	return &fieldView{
+		Name:     v.paramName,
+		Type:     v.paramType,
+		goType:   goType,
+		codeOnly: strings.HasPrefix(v.paramType, "func("),
+	}
 }
 
+// GenerateAssessor calls the matcher with the field followed by arguments from config, matchers without
+// the field and parentheses are used as is.
 func (v *customFunctionParamView) GenerateAssessor(callerName string) string {
-	if v.GenerateField() == "" {
-		return v.funcName
+	args := v.funcArgs
+	if v.GetField() != nil {
+		args = append([]string{callerName + "." + v.paramName}, args...)
+		if v.withEqualOptions {
+			args = append(args, equalOptionsVar+"...")
+		}
+	}
+
+	res := v.funcName
+	if args != nil {
+		res = fmt.Sprintf("%s(%s)", v.funcName, strings.Join(args, ", "))
+	}
+	if v.isAssessor {
+		res = fmt.Sprintf("%s.Arg(%s)", reporterVar, res)
+	}
+
+	return res
+}
+
+// GenerateRecord stores the actual argument into the field when the field type still can hold it.
+func (v *customFunctionParamView) GenerateRecord(callerName string) string {
+	switch v.paramType {
+	case v.argType:
+		return fmt.Sprintf("%s.%s = %s", callerName, v.paramName, v.name)
+	case "[]" + v.argType:
+		return fmt.Sprintf("%s.%s = %s{%s}", callerName, v.paramName, v.paramType, v.name)
+	default:
+		return ""
 	}
+}
 
-	return fmt.Sprintf("%s(%s.%s)", v.funcName, callerName, v.paramName)
+// outParamView describes an argument the method writes its result into, the mock writes the value of the field
+// into it instead of matching it.
+type outParamView struct {
+	argument
 }
 
-func (v *customFunctionParamView) GetPathTypes() []string { return v.pathTypes }
+func newOutParamView(arg argument) *outParamView {
+	res := &outParamView{argument: arg}
+	if !res.isPointer() {
+		res.pathTypes = append(res.pathTypes, "reflect")
+	}
 
-func newParamView(v *parser.Value, i int, fieldOverwriter fieldoverwriter.Overwriter) param {
-	if fieldOverwriter != nil {
-		return newCustomFunctionParamView(v, fieldOverwriter)
+	return res
+}
+
+func (v *outParamView) isPointer() bool {
+	if v.goType != nil {
+		_, ok := v.goType.Underlying().(*types.Pointer)
+
+		return ok
 	}
 
-	t := exprToString(v.Type)
-	switch t {
-	case "context.Context":
-		return &ctxParamView{}
-	case "pgx.Tx":
-		return &txParamView{}
+	return strings.HasPrefix(v.argType, "*")
+}
+
+func (v *outParamView) fieldName() string {
+	return "Set" + capitalize(v.name)
+}
+
+func (v *outParamView) GetField() *fieldView {
+	if !v.isPointer() {
+		return &fieldView{Name: v.fieldName(), Type: v.argType, goType: v.goType}
 	}
-	name := v.Name
-	if name == "" {
-		name = "p" + strconv.Itoa(i)
+
+	var goType types.Type
+	if v.goType != nil {
+		goType = v.goType.Underlying().(*types.Pointer).Elem() //nolint:forcetypeassert
+	}
+
+	return &fieldView{Name: v.fieldName(), Type: strings.TrimPrefix(v.argType, "*"), goType: goType}
+}
+
+// GenerateAssessor matches only the type of the pointer, interfaces are matched by anything.
+func (v *outParamView) GenerateAssessor(string) string {
+	if !v.isPointer() {
+		return "mock.Anything"
+	}
+
+	return fmt.Sprintf("mock.IsType((%s)(nil))", v.argType)
+}
+
+func (v *outParamView) GenerateRecord(string) string { return "" }
+
+// GenerateWrite copies the field into the argument, nil pointers passed as arguments are left as is.
+func (v *outParamView) GenerateWrite(callerName string) string {
+	if v.isPointer() {
+		return fmt.Sprintf("if %[1]s != nil {\n*%[1]s = %[2]s.%[3]s\n}", v.name, callerName, v.fieldName())
+	}
+
+	return fmt.Sprintf(
+		"if %[2]s.%[3]s != nil {\n"+
+			"if _dst := reflect.ValueOf(%[1]s); _dst.Kind() == reflect.Pointer && !_dst.IsNil() {\n"+
+			"_dst.Elem().Set(reflect.ValueOf(%[2]s.%[3]s))\n}\n}",
+		v.name, callerName, v.fieldName(),
+	)
+}
+
+// GenerateRead stores the value written into the argument by the method into the field.
+func (v *outParamView) GenerateRead(callerName string) string {
+	if v.isPointer() {
+		return fmt.Sprintf("if %[1]s != nil {\n%[2]s.%[3]s = *%[1]s\n}", v.name, callerName, v.fieldName())
+	}
+
+	return fmt.Sprintf(
+		"if _v := reflect.ValueOf(%s); _v.Kind() == reflect.Pointer && !_v.IsNil() {\n%s.%s = _v.Elem().Interface()\n}",
+		v.name, callerName, v.fieldName(),
+	)
+}
+
+func newParamView(arg argument, fieldOverwriter fieldoverwriter.Overwriter, isOut bool, anythingVarName string) param {
+	if isOut {
+		return newOutParamView(arg)
+	}
+	if fieldOverwriter != nil {
+		return newCustomFunctionParamView(arg, fieldOverwriter)
 	}
 
-	return &stdParamView{name: capitalize(name), paramType: t, pathTypes: v.PathTypes}
+	if anythingVarName != "" {
+		return &anythingParamView{argument: arg, varName: anythingVarName}
+	}
+
+	return &stdParamView{argument: arg}
 }
 
-func (p *stdParamView) GenerateField() string {
-	return p.name + " " + p.paramType
+func (p *stdParamView) GetField() *fieldView {
+	return &fieldView{Name: capitalize(p.name), Type: p.argType, goType: p.goType}
 }
 
 func (p *stdParamView) GenerateAssessor(callerName string) string {
-	return callerName + "." + p.name
+	field := callerName + "." + capitalize(p.name)
+	switch {
+	case p.withEqualOptions:
+		return fmt.Sprintf("%s.Arg(assessor.Equal(%s, %s...))", reporterVar, field, equalOptionsVar)
+	case p.equal:
+		return fmt.Sprintf("%s.Arg(assessor.Equal(%s))", reporterVar, field)
+	default:
+		return field
+	}
 }
 
-func (p *stdParamView) GetPathTypes() []string {
-	return p.pathTypes
+// equalOptionsVar is the variable holding options of assessor.Equal matching plain params.
+const equalOptionsVar = "equalOptions"
+
+// reporterVar is the variable holding the assessor.Reporter the mock is created with, matchers are passed to testify
+// by it, so failures of the mock explain their mismatches.
+const reporterVar = "reporter"
+
+// equalOptions returns options of assessor.Equal written in Go.
+func equalOptions(cfg *config.EqualityConfig) []string {
+	var res []string
+	if !cfg.IsEnabled() {
+		return nil
+	}
+	if cfg.IgnoreUnexported {
+		res = append(res, "assessor.IgnoreUnexported()")
+	}
+	if cfg.NilEqualsEmpty {
+		res = append(res, "assessor.NilEqualsEmpty()")
+	}
+	if cfg.UseEqualMethods {
+		res = append(res, "assessor.UseEqualMethods()")
+	}
+	if cfg.FloatTolerance != 0 {
+		res = append(res, fmt.Sprintf("assessor.FloatTolerance(%s)", strconv.FormatFloat(cfg.FloatTolerance, 'g', -1, 64)))
+	}
+
+	return res
+}
+
+func (p *stdParamView) GenerateRecord(callerName string) string {
+	return fmt.Sprintf("%s.%s = %s", callerName, capitalize(p.name), p.name)
 }
 
 type returnView struct {
 	Name      string
 	Type      string
+	GoType    types.Type
 	PathTypes []string
 }
 
-func newReturnView(v *parser.Value, i int, returnsRenamer *returnsrenamer.ReturnRenamer) *returnView {
-	t := exprToString(v.Type)
+func newReturnView(v *parser.Value, i int, pkg *types.Package, returnsRenamer *returnsrenamer.ReturnRenamer) *returnView {
+	t := typeString(v, pkg)
 	name := v.Name
 	if name == "" && t == "error" {
 		name = "err"
@@ -165,50 +401,383 @@ func newReturnView(v *parser.Value, i int, returnsRenamer *returnsrenamer.Return
 		name = *newName
 	}
 
-	return &returnView{Name: "Received" + capitalize(name), Type: t, PathTypes: v.PathTypes}
+	return &returnView{Name: "Received" + capitalize(name), Type: t, GoType: v.GoType, PathTypes: v.PathTypes}
 }
 
 type methodView struct {
 	Name    string
 	Params  []param
 	Returns []returnView
+	// RepetitionHelperName is the helper of the interface applying Times and Maybe of descriptors
+	RepetitionHelperName string
+
+	interfaceName string
+	withTags      bool
+	callFields    config.CallFieldsConfig
+	// pkg is the package of the interface, context.Context is looked up among its imports
+	pkg *types.Package
 }
 
 func newMethodView(
+	cfg *config.InterfaceConfig,
+	pkg *types.Package,
 	method *parser.Method,
 	fieldOverwriterStorage *fieldoverwriter.Storage,
 	returnsRenamerStorage *returnsrenamer.Storage,
-) *methodView {
+	outParamsStorage *outparams.Storage,
+	anythingTypesStorage *anythingtypes.Storage,
+) (*methodView, error) {
 	res := &methodView{
 		Name:    method.Name,
 		Params:  make([]param, 0, len(method.Params)),
 		Returns: make([]returnView, 0, len(method.Returns)),
+
+		interfaceName: cfg.Name,
+		withTags:      cfg.Fixtures,
+		callFields:    cfg.CallFields,
+		pkg:           pkg,
 	}
 	for i, param := range method.Params {
-		fieldOverwriter := fieldOverwriterStorage.Get(method.Name, param.Name, i)
-		res.Params = append(res.Params, newParamView(&param, i, fieldOverwriter))
+		fieldOverwriter := fieldOverwriterStorage.Get(method.Name, param.Name, i, param.GoType)
+		isOut := outParamsStorage.IsOut(method.Name, param.Name, i)
+		anythingVarName := anythingTypesStorage.Get(param.GoType)
+		view := newParamView(newArgument(&param, i, pkg), fieldOverwriter, isOut, anythingVarName)
+		switch v := view.(type) {
+		case *stdParamView:
+			if cfg.Equality.IsEnabled() {
+				v.equal = true
+				v.withEqualOptions = len(equalOptions(cfg.Equality)) > 0
+				v.pathTypes = append(slices.Clip(v.pathTypes), assessorPath)
+			}
+		case *customFunctionParamView:
+			v.withEqualOptions = v.takesEqualOptions && len(equalOptions(cfg.Equality)) > 0
+		}
+		res.Params = append(res.Params, view)
 	}
 	returnRenamer := returnsRenamerStorage.GetReturnRenamer(method.Name)
 	for i, r := range method.Returns {
-		res.Returns = append(res.Returns, *newReturnView(&r, i, returnRenamer))
+		res.Returns = append(res.Returns, *newReturnView(&r, i, pkg, returnRenamer))
+	}
+
+	// call fields are named in config regardless of params and returns, so they may clash
+	fields := make(map[string]struct{})
+	for _, field := range res.GetFields() {
+		if _, ok := fields[field.Name]; ok {
+			return nil, fmt.Errorf("%w: %s of %s", errDuplicateField, field.Name, method.Name)
+		}
+		fields[field.Name] = struct{}{}
+	}
+
+	return res, nil
+}
+
+func (m *methodView) GetFields() []fieldView {
+	res := make([]fieldView, 0, len(m.Params)+len(m.Returns))
+	for _, param := range m.Params {
+		if field := param.GetField(); field != nil {
+			res = append(res, *field)
+		}
+		if name := m.getMatcherField(param); name != "" {
+			res = append(res, fieldView{Name: name, Type: "assessor.Matcher", codeOnly: true})
+		}
+	}
+	if name := m.GetCtxField(); name != "" {
+		res = append(res, fieldView{Name: name, Type: "assessor.Matcher", codeOnly: true})
+	}
+	for _, c := range m.GetCaptures() {
+		res = append(res, fieldView{Name: c.Field, Type: "*" + c.Type, codeOnly: true})
+	}
+	for _, r := range m.Returns {
+		res = append(res, fieldView{Name: r.Name, Type: r.Type, goType: r.GoType})
+	}
+	if m.callFields.Times != "" {
+		res = append(res, fieldView{Name: m.callFields.Times, Type: "int", goType: types.Typ[types.Int]})
+	}
+	if m.callFields.Maybe != "" {
+		res = append(res, fieldView{Name: m.callFields.Maybe, Type: "bool", goType: types.Typ[types.Bool]})
+	}
+	if name := m.GetRunField(); name != "" {
+		res = append(res, fieldView{Name: name, Type: "func" + m.GetParamList(), codeOnly: true})
+	}
+	if name := m.GetDoField(); name != "" {
+		res = append(res, fieldView{Name: name, Type: "func" + m.GetSignature(), codeOnly: true})
+	}
+	if name := m.GetPanicField(); name != "" {
+		res = append(res, fieldView{Name: name, Type: "any", goType: types.Universe.Lookup("any").Type()})
+	}
+	if name := m.GetDelayField(); name != "" {
+		res = append(res, fieldView{Name: name, Type: "time.Duration", goType: durationType})
+	}
+	if name := m.GetBlockField(); name != "" {
+		res = append(res, fieldView{Name: name, Type: "bool", goType: types.Typ[types.Bool]})
+	}
+
+	if m.withTags {
+		for i := range res {
+			if res[i].codeOnly {
+				res[i].Tag = "`json:\"-\" yaml:\"-\"`"
+			} else {
+				res[i].Tag = structTag(res[i].Name)
+			}
+		}
 	}
 
 	return res
 }
 
 func (m *methodView) IsAnyField() bool {
-	if len(m.Returns) > 0 {
-		return true
+	return len(m.GetFields()) > 0
+}
+
+// GetSignature returns parameters and results of the method as they are written in the method declaration.
+func (m *methodView) GetSignature() string {
+	results := make([]string, 0, len(m.Returns))
+	for _, r := range m.Returns {
+		results = append(results, r.Type)
 	}
+
+	signature := m.GetParamList()
+	switch len(results) {
+	case 0:
+		return signature
+	case 1:
+		return signature + " " + results[0]
+	default:
+		return signature + " (" + strings.Join(results, ", ") + ")"
+	}
+}
+
+func (m *methodView) GetParamList() string {
+	params := make([]string, 0, len(m.Params))
+	for _, param := range m.Params {
+		argType := param.GetArgType()
+		if param.IsVariadic() {
+			argType = "..." + strings.TrimPrefix(argType, "[]")
+		}
+		params = append(params, param.GetArgName()+" "+argType)
+	}
+
+	return "(" + strings.Join(params, ", ") + ")"
+}
+
+func (m *methodView) GetArgs() string {
+	args := make([]string, 0, len(m.Params))
 	for _, param := range m.Params {
-		if param.GenerateField() != "" {
+		if param.IsVariadic() {
+			args = append(args, param.GetArgName()+"...")
+
+			continue
+		}
+		args = append(args, param.GetArgName())
+	}
+
+	return strings.Join(args, ", ")
+}
+
+func (m *methodView) IsRepeatable() bool {
+	return m.callFields.Times != "" || m.callFields.Maybe != ""
+}
+
+// GetRepetitionArgs returns arguments of the repetition helper taken from the call descriptor.
+func (m *methodView) GetRepetitionArgs(callerName string) string {
+	times, maybe := "0", "false"
+	if m.callFields.Times != "" {
+		times = callerName + "." + m.callFields.Times
+	}
+	if m.callFields.Maybe != "" {
+		maybe = callerName + "." + m.callFields.Maybe
+	}
+
+	return times + ", " + maybe
+}
+
+// GetRunField returns the name of the descriptor field with a function called on every call of the method.
+func (m *methodView) GetRunField() string {
+	return m.callFields.Run
+}
+
+// GetDoField returns the name of the descriptor field with a function computing results of the method,
+// methods without results have no such field.
+func (m *methodView) GetDoField() string {
+	if len(m.Returns) == 0 {
+		return ""
+	}
+
+	return m.callFields.Do
+}
+
+func (m *methodView) GetOutParams() []*outParamView {
+	var res []*outParamView
+	for _, param := range m.Params {
+		if out, ok := param.(*outParamView); ok {
+			res = append(res, out)
+		}
+	}
+
+	return res
+}
+
+func (m *methodView) GetPanicField() string {
+	return m.callFields.Panic
+}
+
+func (m *methodView) GetDelayField() string {
+	return m.callFields.Delay
+}
+
+// GetBlockField returns the name of the descriptor field blocking the call until its context is done,
+// methods without a context param have no such field.
+func (m *methodView) GetBlockField() string {
+	if m.GetCtxArgName() == "" {
+		return ""
+	}
+
+	return m.callFields.BlockUntilCtxDone
+}
+
+func (m *methodView) GetCtxArgName() string {
+	for _, param := range m.Params {
+		if isContext(m.pkg, param) {
+			return param.GetArgName()
+		}
+	}
+
+	return ""
+}
+
+// isContext reports whether the param implements context.Context, so the call can wait for it.
+func isContext(pkg *types.Package, p param) bool {
+	if _, ok := p.(*outParamView); ok {
+		return false
+	}
+
+	goType := p.getGoType()
+	if goType == nil {
+		return p.GetArgType() == "context.Context"
+	}
+
+	return parser.IsContext(pkg, goType)
+}
+
+// captureView describes the descriptor field receiving the argument of the param when the call is matched.
+type captureView struct {
+	Field string
+	Arg   string
+	Type  string
+}
+
+func (m *methodView) GetCaptures() []captureView {
+	if m.callFields.CapturePrefix == "" {
+		return nil
+	}
+
+	var res []captureView
+	for _, param := range m.Params {
+		if _, ok := param.(*outParamView); ok {
+			continue
+		}
+
+		res = append(res, captureView{
+			Field: m.callFields.CapturePrefix + capitalize(param.GetArgName()),
+			Arg:   param.GetArgName(),
+			Type:  param.GetArgType(),
+		})
+	}
+
+	return res
+}
+
+// IsRunWrapped reports whether the mock needs its own Run function, the Run field is called from it then.
+func (m *methodView) IsRunWrapped() bool {
+	return len(m.GetOutParams()) > 0 || m.GetBlockField() != "" || len(m.GetCaptures()) > 0 || m.GetPanicField() != ""
+}
+
+func (m *methodView) IsHooked() bool {
+	return m.GetRunField() != "" || m.GetDoField() != "" || m.GetPanicField() != "" || m.GetDelayField() != "" ||
+		m.IsRunWrapped()
+}
+
+// GetCtxField returns the name of the descriptor field replacing the matcher of the context param for a single call,
+// methods without a context param have no such field.
+func (m *methodView) GetCtxField() string {
+	if m.GetCtxArgName() == "" {
+		return ""
+	}
+
+	return m.callFields.Ctx
+}
+
+// getMatcherField returns the name of the descriptor field replacing the matcher of the param for a single call.
+func (m *methodView) getMatcherField(p param) string {
+	if m.callFields.MatcherSuffix == "" {
+		return ""
+	}
+	if _, ok := p.(*outParamView); ok {
+		return ""
+	}
+
+	field := p.GetField()
+	if field == nil {
+		return ""
+	}
+
+	return field.Name + m.callFields.MatcherSuffix
+}
+
+// GenerateAssessor returns the matcher of the param, it can be replaced by the matcher field of the call.
+func (m *methodView) GenerateAssessor(p param, callerName string) string {
+	res := p.GenerateAssessor(callerName)
+	name := m.getMatcherField(p)
+	if name == "" && m.GetCtxField() != "" && p.GetArgName() == m.GetCtxArgName() {
+		name = m.GetCtxField()
+	}
+	if name != "" {
+		res = fmt.Sprintf("%s.Override(%s.%s, %s)", reporterVar, callerName, name, res)
+	}
+
+	return res
+}
+
+// usesReporter reports whether matchers of the params are passed to testify by the reporter.
+func (m *methodView) usesReporter() bool {
+	if m.GetCtxField() != "" {
+		return true
+	}
+	for _, p := range m.Params {
+		if m.getMatcherField(p) != "" {
 			return true
 		}
+		switch v := p.(type) {
+		case *stdParamView:
+			if v.equal {
+				return true
+			}
+		case *customFunctionParamView:
+			if v.isAssessor {
+				return true
+			}
+		}
 	}
 
 	return false
 }
 
+func (m *methodView) getImports() []string {
+	var res []string
+	for _, param := range m.Params {
+		if m.getMatcherField(param) != "" || m.GetCtxField() != "" {
+			res = append(res, assessorPath)
+
+			break
+		}
+	}
+	if m.GetDelayField() != "" {
+		res = append(res, "time")
+	}
+
+	return res
+}
+
 func (m *methodView) GetStructureName() string {
 	return unCapitalize(m.Name) + "Call"
 }
@@ -217,27 +786,65 @@ func (m *methodView) GetStructureFieldName() string {
 	return capitalize(m.Name)
 }
 
+func (m *methodView) GetStructureFieldTag() string {
+	if !m.withTags {
+		return ""
+	}
+
+	return structTag(m.GetStructureFieldName())
+}
+
 type interfaceView struct {
 	PackageName string
 	Name        string
 	Methods     []methodView
+	Spy         bool
+	Recorder    bool
+	Fixtures    bool
+	Ordered     bool
+	Repeatable  bool
+
+	anythingVars []anythingtypes.Var
+	equalOptions []string
 }
 
 func newInterfaceView(
+	cfg *config.InterfaceConfig,
 	iface *parser.Interface,
 	fieldOverwriterStorage *fieldoverwriter.Storage,
 	returnsRenamerStorage *returnsrenamer.Storage,
-) *interfaceView {
+	outParamsStorage *outparams.Storage,
+	anythingTypesStorage *anythingtypes.Storage,
+) (*interfaceView, error) {
 	res := &interfaceView{
 		PackageName: iface.PackageName,
 		Name:        iface.Name,
 		Methods:     make([]methodView, 0, len(iface.Methods)),
+		Spy:         cfg.Spy || cfg.Recorder,
+		Recorder:    cfg.Recorder,
+		Fixtures:    cfg.Fixtures,
+		Ordered:     cfg.Ordered,
+		Repeatable:  cfg.CallFields.Times != "" || cfg.CallFields.Maybe != "",
+
+		anythingVars: anythingTypesStorage.Vars(),
+		equalOptions: equalOptions(cfg.Equality),
 	}
 	for _, method := range iface.Methods {
-		res.Methods = append(res.Methods, *newMethodView(&method, fieldOverwriterStorage, returnsRenamerStorage))
+		view, err := newMethodView(
+			cfg, iface.Package, &method, fieldOverwriterStorage, returnsRenamerStorage, outParamsStorage, anythingTypesStorage,
+		)
+		if err != nil {
+			return nil, err
+		}
+		view.RepetitionHelperName = res.GetRepetitionHelperName()
+		// descriptors of methods named after the interface, e.g. RepoOrdered of Repo, take the name of the list item
+		if res.Ordered && view.GetStructureName() == res.GetOrderedCallName() {
+			return nil, fmt.Errorf("%w: %s of %s", errDuplicateType, view.GetStructureName(), method.Name)
+		}
+		res.Methods = append(res.Methods, *view)
 	}
 
-	return res
+	return res, nil
 }
 
 func (iv *interfaceView) GetCapitalizedName() string { return capitalize(iv.Name) }
@@ -250,38 +857,92 @@ func (iv *interfaceView) GetConstructureName() string {
 	return "make" + capitalize(iv.Name) + "Mock"
 }
 
+func (iv *interfaceView) GetSpyStructureName() string {
+	return unCapitalize(iv.Name) + "Spy"
+}
+
+func (iv *interfaceView) GetSpyConstructorName() string {
+	return "spy" + capitalize(iv.Name)
+}
+
+func (iv *interfaceView) GetOrderedCallName() string {
+	return unCapitalize(iv.Name) + "OrderedCall"
+}
+
+func (iv *interfaceView) GetOrderedConstructorName() string {
+	return "make" + capitalize(iv.Name) + "OrderedMock"
+}
+
+func (iv *interfaceView) IsAnyMethodField() bool {
+	for _, m := range iv.Methods {
+		if m.IsAnyField() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (iv *interfaceView) GetRepetitionHelperName() string {
+	return "repeat" + capitalize(iv.Name) + "Call"
+}
+
+func (iv *interfaceView) GetLoaderName() string {
+	return "load" + capitalize(iv.Name) + "Calls"
+}
+
+func (iv *interfaceView) GetRecorderConstructorName() string {
+	return "record" + capitalize(iv.Name)
+}
+
+// AdditionalVars declares variables matching params of anything and same instance types used by the interface
+// and options of assessor.Equal.
 func (iv *interfaceView) AdditionalVars() []string {
-	res := make([]string, 0, 2) //nolint:mnd
-	if iv.isCtxRequired() {
-		res = append(res, anyCtxConst+" := mock.Anything")
+	res := make([]string, 0, len(iv.anythingVars))
+	for _, v := range iv.anythingVars {
+		switch {
+		case !iv.isVarRequired(v.Name):
+		case v.SameInstance:
+			res = append(res, v.Name+" := "+reporterVar+".Arg(assessor.SameInstance())")
+		default:
+			res = append(res, v.Name+" := mock.Anything")
+		}
 	}
-	if iv.isTxRequired() {
-		res = append(res, anyTxConst+" := mock.Anything")
+	if iv.isEqualOptionsRequired() {
+		res = append(res, fmt.Sprintf("%s := []assessor.Option{%s}", equalOptionsVar, strings.Join(iv.equalOptions, ", ")))
 	}
 
 	return res
 }
 
-func (iv *interfaceView) GetImports() []string {
-	res := make([]string, 0, 2)
-	res = append(res, "testing", "github.com/stretchr/testify/mock")
-	for _, m := range iv.Methods {
-		for _, param := range m.Params {
-			res = append(res, param.GetPathTypes()...)
+// IsReported reports whether the mock is created with a reporter, which passes matchers to testify.
+func (iv *interfaceView) IsReported() bool {
+	for _, v := range iv.anythingVars {
+		if v.SameInstance && iv.isVarRequired(v.Name) {
+			return true
 		}
-		for _, ret := range m.Returns {
-			res = append(res, ret.PathTypes...)
+	}
+	for _, m := range iv.Methods {
+		if m.usesReporter() {
+			return true
 		}
 	}
 
-	return unique(res)
+	return false
 }
 
-func (iv *interfaceView) isCtxRequired() bool {
+func (iv *interfaceView) isEqualOptionsRequired() bool {
 	for _, m := range iv.Methods {
 		for _, param := range m.Params {
-			if param.GenerateAssessor("") == anyCtxConst {
-				return true
+			switch v := param.(type) {
+			case *stdParamView:
+				if v.withEqualOptions {
+					return true
+				}
+			case *customFunctionParamView:
+				if v.withEqualOptions && v.GetField() != nil {
+					return true
+				}
 			}
 		}
 	}
@@ -289,10 +950,37 @@ func (iv *interfaceView) isCtxRequired() bool {
 	return false
 }
 
-func (iv *interfaceView) isTxRequired() bool {
+func (iv *interfaceView) GetImports() []string {
+	res := make([]string, 0, 2)
+	res = append(res, "testing", "github.com/stretchr/testify/mock")
+	if iv.Spy {
+		res = append(res, "slices", "sync")
+	}
+	if iv.Recorder || iv.Fixtures {
+		res = append(res, "github.com/xgamtx/go-mockery-descriptor/pkg/fixture")
+	}
+	for _, v := range iv.anythingVars {
+		if v.SameInstance && iv.isVarRequired(v.Name) {
+			res = append(res, assessorPath)
+		}
+	}
+	for _, m := range iv.Methods {
+		res = append(res, m.getImports()...)
+		for _, param := range m.Params {
+			res = append(res, param.GetPathTypes()...)
+		}
+		for _, ret := range m.Returns {
+			res = append(res, ret.PathTypes...)
+		}
+	}
+
+	return unique(res)
+}
+
+func (iv *interfaceView) isVarRequired(name string) bool {
 	for _, m := range iv.Methods {
 		for _, param := range m.Params {
-			if param.GenerateAssessor("") == anyTxConst {
+			if v, ok := param.(*anythingParamView); ok && v.varName == name {
 				return true
 			}
 		}
@@ -320,12 +1008,19 @@ func Generate(
 	iface *parser.Interface,
 	fieldOverwriterStorage *fieldoverwriter.Storage,
 	returnsRenamerStorage *returnsrenamer.Storage,
+	outParamsStorage *outparams.Storage,
+	anythingTypesStorage *anythingtypes.Storage,
 ) (string, error) {
-	view := newInterfaceView(iface, fieldOverwriterStorage, returnsRenamerStorage)
+	view, err := newInterfaceView(
+		cfg, iface, fieldOverwriterStorage, returnsRenamerStorage, outParamsStorage, anythingTypesStorage,
+	)
+	if err != nil {
+		return "", err
+	}
 	tmpl := template.New("mock.tmpl")
 
 	fullTemplate := generateTemplate(cfg, tmplContent)
-	tmpl, err := tmpl.Parse(fullTemplate)
+	tmpl, err = tmpl.Parse(fullTemplate)
 	if err != nil {
 		return "", err
 	}
@@ -1,6 +1,9 @@
 package generator
 
-import "strings"
+import (
+	"fmt"
+	"strings"
+)
 
 func capitalize(s string) string {
 	if len(s) == 0 {
@@ -17,3 +20,10 @@ func unCapitalize(s string) string {
 
 	return strings.ToLower(s[:1]) + s[1:]
 }
+
+// structTag returns the tag used to read a descriptor field from JSON and YAML fixtures.
+func structTag(fieldName string) string {
+	name := unCapitalize(fieldName)
+
+	return fmt.Sprintf("`json:\"%s,omitempty\" yaml:\"%s,omitempty\"`", name, name)
+}
@@ -0,0 +1,198 @@
+package generator
+
+import (
+	"encoding/json"
+	"go/types"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"github.com/xgamtx/go-mockery-descriptor/internal/anythingtypes"
+	"github.com/xgamtx/go-mockery-descriptor/internal/config"
+	"github.com/xgamtx/go-mockery-descriptor/internal/fieldoverwriter"
+	"github.com/xgamtx/go-mockery-descriptor/internal/outparams"
+	"github.com/xgamtx/go-mockery-descriptor/internal/parser"
+	"github.com/xgamtx/go-mockery-descriptor/internal/returnsrenamer"
+)
+
+const jsonSchemaDraft = "https://json-schema.org/draft/2020-12/schema"
+
+type schema map[string]any
+
+type schemaBuilder struct {
+	defs schema
+}
+
+// typeSchema describes how values of t are written in fixtures read by fixture.Load.
+func (b *schemaBuilder) typeSchema(t types.Type) schema { //nolint:cyclop
+	if t == nil {
+		return schema{}
+	}
+
+	switch types.TypeString(t, nil) {
+	case "error":
+		return schema{"type": []string{"string", "null"}}
+	case "time.Time":
+		return schema{"type": "string", "format": "date-time"}
+	case "time.Duration":
+		return schema{"type": []string{"string", "integer"}}
+	}
+
+	if isTextUnmarshaler(t) {
+		return schema{"type": "string"}
+	}
+
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		return basicSchema(u)
+	case *types.Pointer:
+		return schema{"anyOf": []schema{b.typeSchema(u.Elem()), {"type": "null"}}}
+	case *types.Slice:
+		if basic, ok := u.Elem().Underlying().(*types.Basic); ok && basic.Kind() == types.Byte {
+			return schema{"type": "string", "contentEncoding": "base64"}
+		}
+
+		return schema{"type": []string{"array", "null"}, "items": b.typeSchema(u.Elem())}
+	case *types.Array:
+		return schema{"type": "array", "items": b.typeSchema(u.Elem()), "minItems": u.Len(), "maxItems": u.Len()}
+	case *types.Map:
+		return schema{"type": []string{"object", "null"}, "additionalProperties": b.typeSchema(u.Elem())}
+	case *types.Struct:
+		return b.structSchema(t, u)
+	default:
+		return schema{}
+	}
+}
+
+func basicSchema(t *types.Basic) schema {
+	switch {
+	case t.Info()&types.IsBoolean != 0:
+		return schema{"type": "boolean"}
+	case t.Info()&types.IsInteger != 0:
+		return schema{"type": "integer"}
+	case t.Info()&types.IsFloat != 0:
+		return schema{"type": "number"}
+	case t.Info()&types.IsString != 0:
+		return schema{"type": "string"}
+	default:
+		return schema{}
+	}
+}
+
+func isTextUnmarshaler(t types.Type) bool {
+	obj, _, _ := types.LookupFieldOrMethod(types.NewPointer(t), true, nil, "UnmarshalText")
+	_, ok := obj.(*types.Func)
+
+	return ok
+}
+
+// structSchema puts schemas of named structures to $defs, so recursive types are supported.
+func (b *schemaBuilder) structSchema(t types.Type, s *types.Struct) schema {
+	named, ok := t.(*types.Named)
+	if !ok {
+		return b.fieldsSchema(s)
+	}
+
+	name := types.TypeString(named, nil)
+	ref := schema{"$ref": defRef(name)}
+	if _, ok := b.defs[name]; ok {
+		return ref
+	}
+
+	b.defs[name] = schema{}
+	b.defs[name] = b.fieldsSchema(s)
+
+	return ref
+}
+
+// defRef refers to the definition of $defs. Names hold import paths, so they are escaped as JSON Pointer tokens
+// and then as the URI fragment.
+func defRef(name string) string {
+	pointer := "/$defs/" + strings.NewReplacer("~", "~0", "/", "~1").Replace(name)
+
+	return "#" + (&url.URL{Fragment: pointer}).EscapedFragment()
+}
+
+func (b *schemaBuilder) fieldsSchema(s *types.Struct) schema {
+	properties := schema{}
+	for i := range s.NumFields() {
+		field := s.Field(i)
+		if !field.Exported() {
+			continue
+		}
+
+		name := field.Name()
+		if tag, ok := reflect.StructTag(s.Tag(i)).Lookup("json"); ok {
+			tagName, _, _ := strings.Cut(tag, ",")
+			if tagName == "-" {
+				continue
+			}
+			if tagName != "" {
+				name = tagName
+			}
+		}
+		properties[name] = b.typeSchema(field.Type())
+	}
+
+	return schema{"type": "object", "properties": properties}
+}
+
+func (b *schemaBuilder) methodSchema(m *methodView) schema {
+	properties := schema{}
+	for _, field := range m.GetFields() {
+		if field.codeOnly {
+			continue
+		}
+		properties[unCapitalize(field.Name)] = b.typeSchema(field.goType)
+	}
+
+	return schema{
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+}
+
+// GenerateSchema returns JSON Schema of fixtures with descriptors of the interface.
+func GenerateSchema(
+	cfg *config.InterfaceConfig,
+	iface *parser.Interface,
+	fieldOverwriterStorage *fieldoverwriter.Storage,
+	returnsRenamerStorage *returnsrenamer.Storage,
+	outParamsStorage *outparams.Storage,
+	anythingTypesStorage *anythingtypes.Storage,
+) (string, error) {
+	view, err := newInterfaceView(
+		cfg, iface, fieldOverwriterStorage, returnsRenamerStorage, outParamsStorage, anythingTypesStorage,
+	)
+	if err != nil {
+		return "", err
+	}
+	b := &schemaBuilder{defs: schema{}}
+
+	properties := schema{}
+	for _, m := range view.Methods {
+		properties[unCapitalize(m.GetStructureFieldName())] = schema{
+			"type":  []string{"array", "null"},
+			"items": b.methodSchema(&m),
+		}
+	}
+
+	res := schema{
+		"$schema":              jsonSchemaDraft,
+		"title":                view.GetStructureName(),
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+	if len(b.defs) > 0 {
+		res["$defs"] = b.defs
+	}
+
+	content, err := json.MarshalIndent(res, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(content) + "\n", nil
+}
@@ -0,0 +1,75 @@
+package outparams
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+)
+
+var errInvalidOutParams = errors.New("invalid out params")
+
+type outParam struct {
+	methodName string
+	paramName  string
+	paramIndex int
+}
+
+func newOutParam(params string) (*outParam, error) {
+	paramsParser := regexp.MustCompile(`^([a-zA-Z0-9]+)\.([a-zA-Z0-9]+)$`)
+	match := paramsParser.FindStringSubmatch(params)
+	if len(match) == 0 {
+		return nil, errInvalidOutParams
+	}
+
+	res := &outParam{methodName: match[1], paramName: match[2], paramIndex: -1}
+	if index, err := strconv.Atoi(match[2]); err == nil {
+		res.paramName = ""
+		res.paramIndex = index
+	}
+
+	return res, nil
+}
+
+func (p *outParam) matches(methodName, paramName string, index int) bool {
+	if methodName != p.methodName {
+		return false
+	}
+	if p.paramName != "" {
+		return paramName == p.paramName
+	}
+
+	return index == p.paramIndex
+}
+
+// Storage keeps params marked as outputs, their values are written by the mock instead of being matched.
+type Storage struct {
+	params []outParam
+}
+
+func NewStorage(params []string) (*Storage, error) {
+	res := make([]outParam, 0, len(params))
+	for _, param := range params {
+		p, err := newOutParam(param)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, *p)
+	}
+
+	return &Storage{params: res}, nil
+}
+
+func (s *Storage) IsOut(methodName, paramName string, index int) bool {
+	if s == nil {
+		return false
+	}
+
+	for _, p := range s.params {
+		if p.matches(methodName, paramName, index) {
+			return true
+		}
+	}
+
+	return false
+}
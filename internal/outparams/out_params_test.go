@@ -0,0 +1,70 @@
+package outparams //nolint:testpackage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewStorage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+
+		params []string
+
+		want       *Storage
+		wantErrMsg string
+	}{
+		{
+			name: "empty params",
+
+			want: &Storage{params: []outParam{}},
+		},
+		{
+			name:   "OK, by name and by index",
+			params: []string{"Scan.dest", "Decode.0"},
+
+			want: &Storage{params: []outParam{
+				{methodName: "Scan", paramName: "dest", paramIndex: -1},
+				{methodName: "Decode", paramIndex: 0},
+			}},
+		},
+		{
+			name:   "invalid param",
+			params: []string{"Scan"},
+
+			wantErrMsg: errInvalidOutParams.Error(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewStorage(tt.params)
+			if tt.wantErrMsg != "" {
+				require.EqualError(t, err, tt.wantErrMsg)
+
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestStorage_IsOut(t *testing.T) {
+	t.Parallel()
+
+	storage, err := NewStorage([]string{"Scan.dest", "Decode.1"})
+	require.NoError(t, err)
+
+	assert.True(t, storage.IsOut("Scan", "dest", 0))
+	assert.False(t, storage.IsOut("Scan", "src", 1))
+	assert.True(t, storage.IsOut("Decode", "v", 1))
+	assert.False(t, storage.IsOut("Decode", "ctx", 0))
+	assert.False(t, storage.IsOut("Other", "dest", 0))
+	assert.False(t, (*Storage)(nil).IsOut("Scan", "dest", 0))
+}
@@ -5,6 +5,7 @@ import (
 	"go/ast"
 	"go/token"
 	"go/types"
+	"sync"
 
 	"golang.org/x/tools/go/packages"
 )
@@ -12,7 +13,10 @@ import (
 type Value struct {
 	Name      string
 	Type      ast.Expr
+	GoType    types.Type
 	PathTypes []string
+	// Variadic marks the last param of a variadic method, GoType is the slice of its elements
+	Variadic bool
 }
 
 type Method struct {
@@ -25,10 +29,30 @@ type Interface struct {
 	PackageName string
 	Name        string
 	Methods     []Method
+	Package     *types.Package
 }
 
-func ParseInterfaceInDir(dir, interfaceName string) (*Interface, error) {
-	cfg := &packages.Config{Mode: packages.NeedSyntax | packages.NeedTypes | packages.NeedTypesInfo}
+// Loader loads packages once per directory, several interfaces of one package are usually generated together.
+type Loader struct {
+	mu       sync.Mutex
+	packages map[string]*packages.Package
+}
+
+func NewLoader() *Loader {
+	return &Loader{packages: make(map[string]*packages.Package)}
+}
+
+func (l *Loader) loadPackage(dir string) (*packages.Package, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if pkg, ok := l.packages[dir]; ok {
+		return pkg, nil
+	}
+
+	cfg := &packages.Config{
+		Mode: packages.NeedSyntax | packages.NeedTypes | packages.NeedTypesInfo | packages.NeedImports | packages.NeedDeps,
+	}
 	pkgs, err := packages.Load(cfg, dir)
 	if err != nil {
 		return nil, err
@@ -38,12 +62,30 @@ func ParseInterfaceInDir(dir, interfaceName string) (*Interface, error) {
 		return nil, fmt.Errorf("expected exactly one package, got %d", len(pkgs))
 	}
 
-	iface, err := getInterfaceByName(pkgs[0].Syntax, interfaceName)
+	l.packages[dir] = pkgs[0]
+
+	return pkgs[0], nil
+}
+
+func (l *Loader) ParseInterfaceInDir(dir, interfaceName string) (*Interface, error) {
+	if dir == "" {
+		dir = "."
+	}
+
+	pkg, err := l.loadPackage(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	iface, err := getInterfaceByName(pkg.Syntax, interfaceName)
 	if err != nil {
 		return nil, err
 	}
 
-	return parseInterface(interfaceName, pkgs[0].Types.Name(), iface, pkgs[0].TypesInfo), nil
+	res := parseInterface(interfaceName, pkg.Types.Name(), iface, pkg.TypesInfo)
+	res.Package = pkg.Types
+
+	return res, nil
 }
 
 func getInterfaceByName(files []*ast.File, name string) (*ast.InterfaceType, error) {
@@ -160,15 +202,33 @@ func extractFields(fields []*ast.Field, typesInfo *types.Info) []Value {
 
 	for _, field := range fields {
 		imports := getImportsForExpr(field.Type, typesInfo)
+		goType := typesInfo.TypeOf(field.Type)
+		_, variadic := field.Type.(*ast.Ellipsis)
 		if len(field.Names) == 0 {
 			// Анонимный параметр (часто в возвращаемых значениях)
-			values = append(values, Value{Name: "", Type: field.Type, PathTypes: imports})
+			values = append(values, Value{Name: "", Type: field.Type, GoType: goType, PathTypes: imports, Variadic: variadic})
 		} else {
 			for _, name := range field.Names {
-				values = append(values, Value{Name: name.Name, Type: field.Type, PathTypes: imports})
+				values = append(values, Value{Name: name.Name, Type: field.Type, GoType: goType, PathTypes: imports, Variadic: variadic})
 			}
 		}
 	}
 
 	return values
 }
+
+// IsContext reports whether the type implements context.Context, which is looked up among packages imported by pkg.
+// Types with the Done method only, e.g. of jobs or lifecycles, are not contexts.
+func IsContext(pkg *types.Package, t types.Type) bool {
+	found := FindPackage(pkg, "context")
+	if found == nil {
+		return false
+	}
+	obj, ok := found.Scope().Lookup("Context").(*types.TypeName)
+	if !ok {
+		return false
+	}
+	iface, ok := obj.Type().Underlying().(*types.Interface)
+
+	return ok && types.Implements(t, iface)
+}
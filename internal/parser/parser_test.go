@@ -0,0 +1,66 @@
+package parser_test
+
+import (
+	"go/types"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xgamtx/go-mockery-descriptor/internal/parser"
+)
+
+func TestLoader_ParseInterfaceInDir(t *testing.T) {
+	t.Parallel()
+
+	loader := parser.NewLoader()
+	first, err := loader.ParseInterfaceInDir("../app", "Some")
+	require.NoError(t, err)
+	second, err := loader.ParseInterfaceInDir("../app", "Some")
+	require.NoError(t, err)
+	assert.Same(t, first.Package, second.Package, "the package is loaded once per loader")
+
+	other, err := parser.NewLoader().ParseInterfaceInDir("../app", "Some")
+	require.NoError(t, err)
+	assert.NotSame(t, first.Package, other.Package, "loaders don't share packages")
+	assert.Equal(t, first.Methods[0].Name, other.Methods[0].Name)
+}
+
+func TestLoader_ParseInterfaceInDir_unknownInterface(t *testing.T) {
+	t.Parallel()
+
+	_, err := parser.NewLoader().ParseInterfaceInDir("../app", "Unknown")
+	assert.Error(t, err)
+}
+
+func TestIsContext(t *testing.T) {
+	t.Parallel()
+
+	iface, err := parser.NewLoader().ParseInterfaceInDir("../app", "Repo")
+	require.NoError(t, err)
+	params := iface.Methods[0].Params
+	require.Len(t, params, 3)
+
+	done := types.NewFunc(0, nil, "Done", types.NewSignatureType(nil, nil, nil, nil, types.NewTuple(
+		types.NewParam(0, nil, "", types.NewChan(types.RecvOnly, types.NewStruct(nil, nil))),
+	), false))
+	lifecycle := types.NewInterfaceType([]*types.Func{done}, nil).Complete()
+
+	assert.True(t, parser.IsContext(iface.Package, params[0].GoType), "TraceContext embeds context.Context")
+	assert.False(t, parser.IsContext(iface.Package, params[1].GoType), "*sql.Tx")
+	assert.False(t, parser.IsContext(iface.Package, lifecycle), "Done only")
+	assert.False(t, parser.IsContext(nil, params[0].GoType), "context is not imported")
+}
+
+func TestLoader_ParseInterfaceInDir_variadic(t *testing.T) {
+	t.Parallel()
+
+	iface, err := parser.NewLoader().ParseInterfaceInDir("../app/compiled", "Dispatcher")
+	require.NoError(t, err)
+	params := iface.Methods[0].Params
+	require.Len(t, params, 2)
+
+	assert.False(t, params[0].Variadic)
+	assert.True(t, params[1].Variadic)
+	assert.Equal(t, "[]string", params[1].GoType.String(), "the variadic param holds a slice")
+}
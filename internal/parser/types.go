@@ -0,0 +1,124 @@
+package parser
+
+import (
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+)
+
+// FindPackage finds the package by path among pkg and packages imported by pkg directly or indirectly.
+func FindPackage(pkg *types.Package, path string) *types.Package {
+	return findPackage(pkg, path, make(map[*types.Package]bool))
+}
+
+func findPackage(pkg *types.Package, path string, seen map[*types.Package]bool) *types.Package {
+	if pkg == nil || seen[pkg] {
+		return nil
+	}
+	if pkg.Path() == path {
+		return pkg
+	}
+
+	seen[pkg] = true
+	for _, imported := range pkg.Imports() {
+		if found := findPackage(imported, path, seen); found != nil {
+			return found
+		}
+	}
+
+	return nil
+}
+
+// ResolveType finds the type written with full package paths, e.g. []github.com/acme/model.ID, among packages
+// imported by pkg. Named types, pointers, slices, arrays, maps, channels and the empty interface are supported,
+// the result is nil for other types and for types of packages not imported by pkg, they can't be used in its
+// interfaces anyway.
+func ResolveType(pkg *types.Package, expr string) types.Type { //nolint:cyclop
+	expr = strings.TrimSpace(expr)
+	switch {
+	case expr == "interface{}":
+		return types.NewInterfaceType(nil, nil)
+	case strings.HasPrefix(expr, "*"):
+		return newType(ResolveType(pkg, expr[1:]), func(elem types.Type) types.Type { return types.NewPointer(elem) })
+	case strings.HasPrefix(expr, "[]"):
+		return newType(ResolveType(pkg, expr[2:]), func(elem types.Type) types.Type { return types.NewSlice(elem) })
+	case strings.HasPrefix(expr, "map["):
+		end := closingBracket(expr, len("map"))
+		if end < 0 {
+			return nil
+		}
+		key, value := ResolveType(pkg, expr[len("map["):end]), ResolveType(pkg, expr[end+1:])
+		if key == nil || value == nil {
+			return nil
+		}
+
+		return types.NewMap(key, value)
+	case strings.HasPrefix(expr, "["):
+		end := closingBracket(expr, 0)
+		if end < 0 {
+			return nil
+		}
+		length, err := strconv.ParseInt(expr[1:end], 10, 64)
+		if err != nil {
+			return nil
+		}
+
+		return newType(ResolveType(pkg, expr[end+1:]), func(elem types.Type) types.Type { return types.NewArray(elem, length) })
+	case strings.HasPrefix(expr, "chan<- "):
+		return newChan(ResolveType(pkg, expr[len("chan<- "):]), types.SendOnly)
+	case strings.HasPrefix(expr, "<-chan "):
+		return newChan(ResolveType(pkg, expr[len("<-chan "):]), types.RecvOnly)
+	case strings.HasPrefix(expr, "chan "):
+		return newChan(ResolveType(pkg, expr[len("chan "):]), types.SendRecv)
+	}
+
+	scope := types.Universe
+	name := expr
+	if i := strings.LastIndex(expr, "."); i >= 0 {
+		found := FindPackage(pkg, expr[:i])
+		if found == nil {
+			return nil
+		}
+		scope, name = found.Scope(), expr[i+1:]
+	}
+	if !token.IsIdentifier(name) {
+		return nil
+	}
+	obj, ok := scope.Lookup(name).(*types.TypeName)
+	if !ok {
+		return nil
+	}
+
+	return obj.Type()
+}
+
+func newType(elem types.Type, wrap func(types.Type) types.Type) types.Type {
+	if elem == nil {
+		return nil
+	}
+
+	return wrap(elem)
+}
+
+func newChan(elem types.Type, dir types.ChanDir) types.Type {
+	return newType(elem, func(elem types.Type) types.Type { return types.NewChan(dir, elem) })
+}
+
+// closingBracket returns the index of the bracket closing the one at start, it is negative when there is none.
+func closingBracket(expr string, start int) int {
+	depth := 0
+	for i := start; i < len(expr); i++ {
+		switch expr[i] {
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+
+	return -1
+}
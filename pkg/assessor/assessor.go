@@ -1,7 +1,9 @@
 package assessor
 
 import (
+	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/stretchr/testify/mock"
 )
@@ -10,40 +12,70 @@ type Matcher interface {
 	Matches(argument any) bool
 }
 
-func ElementsMatch[T any](expected []T) Matcher {
-	return mock.MatchedBy(func(actual []T) bool {
-		if len(actual) != len(expected) {
-			return false
-		}
+// Arg turns the matcher into an argument of testify expectations. testify calls Matches only of matchers created
+// by mock.MatchedBy, so other matchers, including the ones of this package, are wrapped into it.
+// Reporter.Arg also explains mismatches of the matcher in failures of the mock.
+func Arg(matcher Matcher) any {
+	if reflect.TypeOf(matcher) == matchedByType {
+		return matcher
+	}
 
-		found := make([]bool, len(actual))
-		for _, actualItem := range actual {
-			var foundItem bool
-			for i, expectedItem := range expected {
-				if found[i] {
-					continue
-				}
+	return mock.MatchedBy(func(actual any) bool { return matcher.Matches(actual) })
+}
 
-				if reflect.DeepEqual(actualItem, expectedItem) {
-					found[i] = true
-					foundItem = true
+// Override returns matcher as an argument of testify expectations when it is set and expected otherwise.
+func Override(matcher Matcher, expected any) any {
+	if isNil(matcher) {
+		return expected
+	}
 
-					break
-				}
-			}
-			if !foundItem {
-				return false
-			}
+	return Arg(matcher)
+}
+
+// isNil reports whether the matcher is unset, including nil pointers of matcher types.
+func isNil(matcher Matcher) bool {
+	return matcher == nil || (reflect.ValueOf(matcher).Kind() == reflect.Pointer && reflect.ValueOf(matcher).IsNil())
+}
+
+var matchedByType = reflect.TypeOf(mock.MatchedBy(func(any) bool { return true })) //nolint:gochecknoglobals
+
+// ElementsMatch matches slices holding the expected elements in any order, elements are compared with the options.
+// Elements are bucketed by hashes, so large slices are matched in linear time unless most elements are equal.
+// A mismatch is explained by missing and unexpected elements.
+// Unlike the matcher of mock.MatchedBy it returned before, the matcher is compared by testify as a plain value,
+// so pass it to expectations by Arg or Reporter.Arg.
+func ElementsMatch[T any](expected []T, opts ...Option) Matcher {
+	description := fmt.Sprintf("%d elements in any order", len(expected))
+	if len(expected) <= maxDiffs {
+		description = fmt.Sprintf("elements of %#v in any order", expected)
+	}
+
+	return newExplainingMatcher(description, func(actual any) (bool, string) {
+		actualSlice, ok := as[[]T](actual)
+		if !ok {
+			return false, ""
+		}
+
+		e := newEquality(opts)
+		if e.equalUnordered(reflect.ValueOf(expected), reflect.ValueOf(actualSlice), "") {
+			return true, ""
 		}
 
-		return true
+		return false, strings.Join(e.diffs, "; ")
 	})
 }
 
-func OneOf[T any](expected []T) Matcher {
-	return mock.MatchedBy(func(actual T) bool {
+// OneOf matches arguments equal to any of the expected values, values are compared with the options.
+// Like ElementsMatch, it is passed to expectations by Arg or Reporter.Arg.
+func OneOf[T any](expected []T, opts ...Option) Matcher {
+	return newMatcher(fmt.Sprintf("one of %#v", expected), func(actual any) bool {
+		actualItem, ok := as[T](actual)
+		if !ok {
+			return false
+		}
+
 		for _, expectedItem := range expected {
-			if reflect.DeepEqual(actual, expectedItem) {
+			if equalValues(expectedItem, actualItem, opts) {
 				return true
 			}
 		}
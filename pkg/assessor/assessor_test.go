@@ -1,9 +1,14 @@
 package assessor_test
 
 import (
+	"fmt"
+	"math"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/xgamtx/go-mockery-descriptor/pkg/assessor"
 )
@@ -107,3 +112,196 @@ func TestOneOf(t *testing.T) {
 		})
 	}
 }
+
+type evenMatcher struct{}
+
+func (evenMatcher) Matches(argument any) bool {
+	v, ok := argument.(int)
+
+	return ok && v%2 == 0
+}
+
+func TestOverride(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name    string
+		matcher assessor.Matcher
+		actual  any
+		wantRes bool
+	}
+	tests := []testCase{
+		{
+			name:    "no matcher",
+			matcher: nil,
+			actual:  1,
+			wantRes: true,
+		},
+		{
+			name:    "no matcher, different value",
+			matcher: nil,
+			actual:  2,
+			wantRes: false,
+		},
+		{
+			name:    "testify matcher",
+			matcher: assessor.OneOf([]int{2, 3}),
+			actual:  3,
+			wantRes: true,
+		},
+		{
+			name:    "custom matcher",
+			matcher: evenMatcher{},
+			actual:  4,
+			wantRes: true,
+		},
+		{
+			name:    "custom matcher, not matched",
+			matcher: evenMatcher{},
+			actual:  1,
+			wantRes: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			args := mock.Arguments{assessor.Override(tt.matcher, 1)}
+			_, diffs := args.Diff([]any{tt.actual})
+			assert.Equal(t, tt.wantRes, diffs == 0)
+		})
+	}
+}
+
+func TestElementsMatch_diff(t *testing.T) {
+	t.Parallel()
+
+	explanation := assessor.Explain(assessor.ElementsMatch([]string{"a", "b", "c"}), []string{"c", "d", "a"})
+	assert.Contains(t, explanation, `[1]: missing "b"; [1]: unexpected "d"`)
+}
+
+type row struct {
+	ID     int
+	Name   string
+	Tags   []string
+	Amount float64
+}
+
+func rows(n int) []row {
+	res := make([]row, n)
+	for i := range res {
+		res[i] = row{ID: i, Name: fmt.Sprintf("row %d", i), Tags: []string{"import"}, Amount: float64(i) / 100}
+	}
+
+	return res
+}
+
+func reversed[T any](s []T) []T {
+	res := slices.Clone(s)
+	slices.Reverse(res)
+
+	return res
+}
+
+func TestElementsMatch_hashed(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	type testCase struct {
+		name    string
+		matcher assessor.Matcher
+		actual  any
+		wantRes bool
+	}
+	now := time.Now()
+	expected := rows(100)
+	changed := reversed(expected)
+	changed[10].Tags = []string{"export"}
+	tests := []testCase{
+		{
+			name:    "structs",
+			matcher: assessor.ElementsMatch(expected),
+			actual:  reversed(expected),
+			wantRes: true,
+		},
+		{
+			name:    "structs, changed nested slice",
+			matcher: assessor.ElementsMatch(expected),
+			actual:  changed,
+			wantRes: false,
+		},
+		{
+			name:    "interfaces",
+			matcher: assessor.ElementsMatch([]any{1, "1", []int{1}, nil}),
+			actual:  []any{nil, []int{1}, "1", 1},
+			wantRes: true,
+		},
+		{
+			name:    "float tolerance",
+			matcher: assessor.ElementsMatch([]float64{1, 2}, assessor.FloatTolerance(0.01)),
+			actual:  []float64{2.001, 0.999},
+			wantRes: true,
+		},
+		{
+			name:    "negative zero",
+			matcher: assessor.ElementsMatch([]float64{0}),
+			actual:  []float64{math.Copysign(0, -1)},
+			wantRes: true,
+		},
+		{
+			name:    "nil equals empty",
+			matcher: assessor.ElementsMatch([][]int{nil, {1}}, assessor.NilEqualsEmpty()),
+			actual:  [][]int{{1}, {}},
+			wantRes: true,
+		},
+		{
+			name:    "equal methods",
+			matcher: assessor.ElementsMatch([]time.Time{now.Round(0)}, assessor.UseEqualMethods()),
+			actual:  []time.Time{now},
+			wantRes: true,
+		},
+		{
+			name:    "ignore order at any depth",
+			matcher: assessor.ElementsMatch([][]int{{1, 2}, {3}}, assessor.IgnoreOrder()),
+			actual:  [][]int{{3}, {2, 1}},
+			wantRes: true,
+		},
+		{
+			name:    "ignore unexported",
+			matcher: assessor.ElementsMatch([]measurement{{Value: 1, cache: map[string]int{"a": 1}}}, assessor.IgnoreUnexported()),
+			actual:  []measurement{{Value: 1}},
+			wantRes: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			args := mock.Arguments{assessor.Arg(tt.matcher)}
+			_, diffs := args.Diff([]any{tt.actual})
+			assert.Equal(t, tt.wantRes, diffs == 0)
+		})
+	}
+}
+
+func BenchmarkElementsMatch(b *testing.B) {
+	for _, n := range []int{100, 10000} {
+		ints := make([]int, n)
+		for i := range ints {
+			ints[i] = i
+		}
+		b.Run(fmt.Sprintf("ints/%d", n), func(b *testing.B) {
+			matcher, actual := assessor.ElementsMatch(ints), reversed(ints)
+			for b.Loop() {
+				matcher.Matches(actual)
+			}
+		})
+
+		structs := rows(n)
+		b.Run(fmt.Sprintf("structs/%d", n), func(b *testing.B) {
+			matcher, actual := assessor.ElementsMatch(structs), reversed(structs)
+			for b.Loop() {
+				matcher.Matches(actual)
+			}
+		})
+	}
+}
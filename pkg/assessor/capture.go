@@ -0,0 +1,48 @@
+package assessor
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// Capture returns a function for Run of a testify call storing the argument at the index into dst. testify runs
+// it for the call matching the expectation only, unlike matchers called for every expectation of the method.
+func Capture[T any](index int, dst *T) func(args mock.Arguments) {
+	var mu sync.Mutex
+
+	return func(args mock.Arguments) {
+		v := argument[T](args, index)
+		mu.Lock()
+		*dst = v
+		mu.Unlock()
+	}
+}
+
+// CaptureAll returns a function for Run of a testify call appending the argument at the index to dst.
+func CaptureAll[T any](index int, dst *[]T) func(args mock.Arguments) {
+	var mu sync.Mutex
+
+	return func(args mock.Arguments) {
+		v := argument[T](args, index)
+		mu.Lock()
+		*dst = append(*dst, v)
+		mu.Unlock()
+	}
+}
+
+// argument panics like getters of mock.Arguments when the argument is missing or of another type.
+func argument[T any](args mock.Arguments, index int) T {
+	if index < 0 || index >= len(args) {
+		panic(fmt.Sprintf("assessor: cannot capture argument %d of %d", index, len(args)))
+	}
+
+	v, ok := as[T](args[index])
+	if !ok {
+		panic(fmt.Sprintf("assessor: cannot capture argument %d of type %T as %s", index, args[index], reflect.TypeFor[T]()))
+	}
+
+	return v
+}
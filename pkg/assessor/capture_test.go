@@ -0,0 +1,69 @@
+package assessor_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/xgamtx/go-mockery-descriptor/pkg/assessor"
+)
+
+type capturer struct {
+	mock.Mock
+}
+
+func (c *capturer) F(x any) {
+	c.Called(x)
+}
+
+func TestCapture(t *testing.T) {
+	t.Parallel()
+
+	var id string
+	assessor.Capture(0, &id)(mock.Arguments{"generated-1"})
+	assert.Equal(t, "generated-1", id)
+
+	var err error
+	assessor.Capture(1, &err)(mock.Arguments{"generated-1", nil})
+	assert.NoError(t, err)
+
+	assert.PanicsWithValue(t, "assessor: cannot capture argument 0 of type int as string", func() {
+		assessor.Capture(0, &id)(mock.Arguments{1})
+	})
+	assert.PanicsWithValue(t, "assessor: cannot capture argument 1 of 1", func() {
+		assessor.Capture(1, &id)(mock.Arguments{"generated-2"})
+	})
+	assert.Equal(t, "generated-1", id)
+}
+
+func TestCaptureAll(t *testing.T) {
+	t.Parallel()
+
+	var errs []error
+	capture := assessor.CaptureAll(0, &errs)
+	for _, actual := range []any{assert.AnError, nil} {
+		capture(mock.Arguments{actual})
+	}
+	assert.Equal(t, []error{assert.AnError, nil}, errs)
+}
+
+func TestCapture_matchedCallOnly(t *testing.T) {
+	t.Parallel()
+
+	var (
+		all []any
+		one any
+	)
+	m := &capturer{}
+	m.Test(t)
+	m.On("F", mock.Anything).Run(assessor.CaptureAll(0, &all)).Once()
+	m.On("F", mock.Anything).Run(assessor.Capture(0, &one)).Once()
+
+	m.F(1)
+	m.F(2)
+
+	m.AssertExpectations(t)
+	assert.Equal(t, []any{1}, all)
+	assert.Equal(t, 2, one)
+}
@@ -0,0 +1,170 @@
+package assessor
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// matchElements pairs elements of expected with distinct equal elements of actual, both are slices or arrays
+// of the same type compared with the options. It returns indexes of unpaired elements of both.
+//
+// Elements are bucketed by a key which is the same for equal elements: the elements themselves when they are
+// equal exactly when they are ==, and their hashes otherwise. Only elements of the same bucket are compared.
+func matchElements(
+	expected, actual reflect.Value, o options, equal func(x, y reflect.Value) bool,
+) (missing, extra []int) { //nolint:nonamedreturns
+	if o.isPlain(expected.Type().Elem()) && expected.CanInterface() && actual.CanInterface() {
+		return pairElements(expected, actual, reflect.Value.Interface, nil)
+	}
+
+	return pairElements(expected, actual, newHasher(o).hash, equal)
+}
+
+// pairElements pairs elements having the same key, equal compares them unless the key is the element itself.
+func pairElements[K comparable](
+	expected, actual reflect.Value, key func(reflect.Value) K, equal func(x, y reflect.Value) bool,
+) (missing, extra []int) { //nolint:nonamedreturns
+	buckets := make(map[K][]int)
+	for j := range actual.Len() {
+		k := key(actual.Index(j))
+		buckets[k] = append(buckets[k], j)
+	}
+
+	paired := make([]bool, actual.Len())
+	for i := range expected.Len() {
+		x := expected.Index(i)
+		k := key(x)
+		candidates := buckets[k]
+		found := slices.IndexFunc(candidates, func(j int) bool { return equal == nil || equal(x, actual.Index(j)) })
+		if found < 0 {
+			missing = append(missing, i)
+
+			continue
+		}
+
+		paired[candidates[found]] = true
+		buckets[k] = slices.Delete(candidates, found, found+1)
+	}
+	for j, ok := range paired {
+		if !ok {
+			extra = append(extra, j)
+		}
+	}
+
+	return missing, extra
+}
+
+// matchSlices pairs elements of expected with elements of actual compared with the options, actual has to be
+// a slice of T.
+func matchSlices[T any](
+	expected []T, actual any, opts []Option,
+) (missing, extra []int, ok bool) { //nolint:nonamedreturns
+	actualSlice, ok := as[[]T](actual)
+	if !ok {
+		return nil, nil, false
+	}
+
+	o := newEquality(opts).options
+	equal := func(x, y reflect.Value) bool { return newEquality(opts).equal(x, y, "") }
+	missing, extra = matchElements(reflect.ValueOf(expected), reflect.ValueOf(actualSlice), o, equal)
+
+	return missing, extra, true
+}
+
+// Superset matches slices containing all expected elements in any order, repeated elements have to be repeated
+// in the argument too.
+func Superset[T any](expected []T, opts ...Option) Matcher {
+	return newMatcher(fmt.Sprintf("slice containing %#v", expected), func(actual any) bool {
+		missing, _, ok := matchSlices(expected, actual, opts)
+
+		return ok && len(missing) == 0
+	})
+}
+
+// Subset matches slices having only expected elements in any order, repeated elements have to be repeated
+// in expected too.
+func Subset[T any](expected []T, opts ...Option) Matcher {
+	return newMatcher(fmt.Sprintf("slice of elements of %#v", expected), func(actual any) bool {
+		_, extra, ok := matchSlices(expected, actual, opts)
+
+		return ok && len(extra) == 0
+	})
+}
+
+// MapContains matches maps having at least the expected keys with the expected values, values of expected may be
+// matchers.
+func MapContains[K comparable, V any](expected map[K]V, opts ...Option) Matcher {
+	operands := make(map[K]operand, len(expected))
+	for k, v := range expected {
+		if matcher, ok := any(v).(Matcher); ok {
+			operands[k] = newOperand(matcher)
+		} else {
+			operands[k] = operand{match: func(actual any) bool { return equalValues(v, actual, opts) }}
+		}
+	}
+
+	return newMatcher(fmt.Sprintf("map containing %#v", expected), func(actual any) bool {
+		actualMap, ok := as[map[K]V](actual)
+		if !ok {
+			return false
+		}
+
+		for k, o := range operands {
+			v, ok := actualMap[k]
+			if !ok || !o.match(v) {
+				return false
+			}
+		}
+
+		return true
+	})
+}
+
+// Each matches slices, arrays and maps whose every element matches the expectation, a matcher or a value
+// compared by equality. Empty collections match.
+func Each(expected any) Matcher {
+	o := newOperand(expected)
+
+	return newMatcher("collection of elements matching "+o.description, func(actual any) bool {
+		v := reflect.ValueOf(actual)
+		switch v.Kind() { //nolint:exhaustive
+		case reflect.Slice, reflect.Array:
+			for i := range v.Len() {
+				if !o.match(v.Index(i).Interface()) {
+					return false
+				}
+			}
+		case reflect.Map:
+			iter := v.MapRange()
+			for iter.Next() {
+				if !o.match(iter.Value().Interface()) {
+					return false
+				}
+			}
+		default:
+			return false
+		}
+
+		return true
+	})
+}
+
+// Len matches slices, arrays, maps, strings and channels of length n.
+func Len(n int) Matcher {
+	return newMatcher(fmt.Sprintf("length %d", n), func(actual any) bool {
+		v := reflect.ValueOf(actual)
+		switch v.Kind() { //nolint:exhaustive
+		case reflect.Slice, reflect.Array, reflect.Map, reflect.String, reflect.Chan:
+			return v.Len() == n
+		default:
+			return false
+		}
+	})
+}
+
+// UnorderedEqual matches arguments equal to expected, slices and arrays are compared regardless of the order
+// of elements at any depth.
+func UnorderedEqual(expected any, opts ...Option) Matcher {
+	return Equal(expected, append([]Option{IgnoreOrder()}, opts...)...)
+}
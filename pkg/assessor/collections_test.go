@@ -0,0 +1,134 @@
+package assessor_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/xgamtx/go-mockery-descriptor/pkg/assessor"
+)
+
+type group struct {
+	Name    string
+	Members []string
+}
+
+func TestCollectionMatchers(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	type testCase struct {
+		name    string
+		matcher assessor.Matcher
+		actual  any
+		wantRes bool
+	}
+	tests := []testCase{
+		{
+			name:    "superset",
+			matcher: assessor.Superset([]int{3, 1}),
+			actual:  []int{1, 2, 3},
+			wantRes: true,
+		},
+		{
+			name:    "superset, repeated element",
+			matcher: assessor.Superset([]int{1, 1}),
+			actual:  []int{1, 2, 3},
+			wantRes: false,
+		},
+		{
+			name:    "subset",
+			matcher: assessor.Subset([]string{"a", "b", "c"}),
+			actual:  []string{"c", "a"},
+			wantRes: true,
+		},
+		{
+			name:    "not a subset",
+			matcher: assessor.Subset([]string{"a", "b"}),
+			actual:  []string{"a", "d"},
+			wantRes: false,
+		},
+		{
+			name:    "subset, different type",
+			matcher: assessor.Subset([]string{"a"}),
+			actual:  []int{1},
+			wantRes: false,
+		},
+		{
+			name:    "map contains values and matchers",
+			matcher: assessor.MapContains(map[string]any{"id": 1, "name": assessor.HasPrefix("b")}),
+			actual:  map[string]any{"id": 1, "name": "bob", "age": 30},
+			wantRes: true,
+		},
+		{
+			name:    "map misses a key",
+			matcher: assessor.MapContains(map[string]int{"id": 1}),
+			actual:  map[string]int{"age": 30},
+			wantRes: false,
+		},
+		{
+			name:    "each element matches",
+			matcher: assessor.Each(assessor.Between(1, 9)),
+			actual:  []int{1, 5, 9},
+			wantRes: true,
+		},
+		{
+			name:    "each map value matches",
+			matcher: assessor.Each("x"),
+			actual:  map[int]string{1: "x", 2: "y"},
+			wantRes: false,
+		},
+		{
+			name:    "each of empty",
+			matcher: assessor.Each(1),
+			actual:  []int{},
+			wantRes: true,
+		},
+		{
+			name:    "len",
+			matcher: assessor.Len(2),
+			actual:  map[string]int{"a": 1, "b": 2},
+			wantRes: true,
+		},
+		{
+			name:    "len differs",
+			matcher: assessor.Len(2),
+			actual:  "abc",
+			wantRes: false,
+		},
+		{
+			name: "unordered at any depth",
+			matcher: assessor.UnorderedEqual([]group{
+				{Name: "a", Members: []string{"x", "y"}},
+				{Name: "b", Members: []string{"z"}},
+			}),
+			actual: []group{
+				{Name: "b", Members: []string{"z"}},
+				{Name: "a", Members: []string{"y", "x"}},
+			},
+			wantRes: true,
+		},
+		{
+			name:    "unordered, different element",
+			matcher: assessor.UnorderedEqual([]group{{Name: "a", Members: []string{"x", "y"}}}),
+			actual:  []group{{Name: "a", Members: []string{"y", "y"}}},
+			wantRes: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			args := mock.Arguments{assessor.Arg(tt.matcher)}
+			_, diffs := args.Diff([]any{tt.actual})
+			assert.Equal(t, tt.wantRes, diffs == 0)
+		})
+	}
+}
+
+func TestUnorderedEqual_diff(t *testing.T) {
+	t.Parallel()
+
+	explanation := assessor.Explain(assessor.UnorderedEqual([]int{1, 2, 3}), []int{3, 4, 1})
+	assert.Contains(t, explanation, "[1]: missing 2; [1]: unexpected 4")
+}
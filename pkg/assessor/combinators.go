@@ -0,0 +1,41 @@
+package assessor
+
+import "fmt"
+
+// AllOf matches arguments matching every expectation, expectations are matchers or values compared by equality.
+func AllOf(expected ...any) Matcher {
+	operands := newOperands(expected)
+
+	return newExplainingMatcher(fmt.Sprintf("all of (%s)", describeOperands(operands)), func(actual any) (bool, string) {
+		for _, o := range operands {
+			if !o.match(actual) {
+				return false, o.explanation(actual)
+			}
+		}
+
+		return true, ""
+	})
+}
+
+// AnyOf matches arguments matching at least one expectation, expectations are matchers or values compared
+// by equality.
+func AnyOf(expected ...any) Matcher {
+	operands := newOperands(expected)
+
+	return newMatcher(fmt.Sprintf("any of (%s)", describeOperands(operands)), func(actual any) bool {
+		for _, o := range operands {
+			if o.match(actual) {
+				return true
+			}
+		}
+
+		return false
+	})
+}
+
+// Not matches arguments not matching the expectation, a matcher or a value compared by equality.
+func Not(expected any) Matcher {
+	o := newOperand(expected)
+
+	return newMatcher("not "+o.description, func(actual any) bool { return !o.match(actual) })
+}
@@ -0,0 +1,133 @@
+package assessor_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/xgamtx/go-mockery-descriptor/pkg/assessor"
+)
+
+func isEven() assessor.Matcher {
+	return assessor.Predicate("even number", func(actual int) bool { return actual%2 == 0 })
+}
+
+func TestCombinators(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	type testCase struct {
+		name    string
+		matcher assessor.Matcher
+		actual  any
+		wantRes bool
+	}
+	tests := []testCase{
+		{
+			name:    "predicate",
+			matcher: isEven(),
+			actual:  4,
+			wantRes: true,
+		},
+		{
+			name:    "predicate, different type",
+			matcher: isEven(),
+			actual:  "4",
+			wantRes: false,
+		},
+		{
+			name:    "predicate, nil of non-nillable type",
+			matcher: isEven(),
+			actual:  nil,
+			wantRes: false,
+		},
+		{
+			name:    "predicate, nil of nillable type",
+			matcher: assessor.Predicate("nil error", func(actual error) bool { return actual == nil }),
+			actual:  nil,
+			wantRes: true,
+		},
+		{
+			name:    "all of matchers and values",
+			matcher: assessor.AllOf(isEven(), mock.Anything, 4),
+			actual:  4,
+			wantRes: true,
+		},
+		{
+			name:    "all of, one is not matched",
+			matcher: assessor.AllOf(isEven(), 6),
+			actual:  4,
+			wantRes: false,
+		},
+		{
+			name:    "any of",
+			matcher: assessor.AnyOf(3, isEven()),
+			actual:  4,
+			wantRes: true,
+		},
+		{
+			name:    "any of, none is matched",
+			matcher: assessor.AnyOf(3, isEven()),
+			actual:  5,
+			wantRes: false,
+		},
+		{
+			name:    "not",
+			matcher: assessor.Not(isEven()),
+			actual:  5,
+			wantRes: true,
+		},
+		{
+			name:    "not a value",
+			matcher: assessor.Not(5),
+			actual:  5,
+			wantRes: false,
+		},
+		{
+			name:    "nested with testify matcher",
+			matcher: assessor.Not(assessor.AnyOf(mock.MatchedBy(func(s string) bool { return s == "" }), "x")),
+			actual:  "y",
+			wantRes: true,
+		},
+		{
+			name:    "panicking testify matcher does not match",
+			matcher: assessor.AnyOf(mock.MatchedBy(func(s *string) bool { return *s == "" })),
+			actual:  (*string)(nil),
+			wantRes: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.wantRes, tt.matcher.Matches(tt.actual))
+
+			args := mock.Arguments{assessor.Arg(tt.matcher)}
+			_, diffs := args.Diff([]any{tt.actual})
+			assert.Equal(t, tt.wantRes, diffs == 0)
+		})
+	}
+}
+
+func TestCombinators_description(t *testing.T) {
+	t.Parallel()
+
+	matcher := assessor.AllOf(assessor.Not(assessor.AnyOf(3, 5)), isEven())
+
+	assert.Equal(t, "all of (not any of (3, 5), even number)", fmt.Sprint(matcher))
+	assert.Equal(t, "expected all of (not any of (3, 5), even number), got 7: expected even number, got 7",
+		assessor.Explain(matcher, 7))
+	assert.Empty(t, assessor.Explain(matcher, 4))
+}
+
+func TestCombinators_statefulOperands(t *testing.T) {
+	t.Parallel()
+
+	first, second := &handle{}, &handle{}
+	matcher := assessor.AllOf(assessor.SameInstance(), assessor.Not(nil))
+
+	assert.True(t, matcher.Matches(first))
+	assert.True(t, matcher.Matches(first))
+	assert.False(t, matcher.Matches(second))
+}
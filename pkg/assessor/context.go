@@ -0,0 +1,79 @@
+package assessor
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// ContextHasDeadline matches contexts with a deadline.
+func ContextHasDeadline() Matcher {
+	return newContextMatcher("context with a deadline", func(actual context.Context) bool {
+		_, ok := actual.Deadline()
+
+		return ok
+	})
+}
+
+// ContextNotCancelled matches contexts that are not done yet.
+func ContextNotCancelled() Matcher {
+	return newContextMatcher("context not cancelled", func(actual context.Context) bool {
+		return actual.Err() == nil
+	})
+}
+
+// ContextValue matches contexts holding the expected value under the key.
+func ContextValue(key, expected any) Matcher {
+	description := fmt.Sprintf("context with %#v under %#v", expected, key)
+
+	return newContextMatcher(description, func(actual context.Context) bool {
+		return reflect.DeepEqual(actual.Value(key), expected)
+	})
+}
+
+// ContextDerivedFrom matches the parent itself and contexts created from it by functions of the context package.
+func ContextDerivedFrom(parent context.Context) Matcher {
+	return newContextMatcher("context derived from the parent", func(actual context.Context) bool {
+		for actual != nil {
+			if actual == parent {
+				return true
+			}
+
+			actual = parentContext(actual)
+		}
+
+		return false
+	})
+}
+
+// newContextMatcher creates a matcher of non-nil contexts.
+func newContextMatcher(description string, match func(actual context.Context) bool) Matcher {
+	return newMatcher(description, func(actual any) bool {
+		ctx, ok := actual.(context.Context)
+
+		return ok && match(ctx)
+	})
+}
+
+// parentContext returns the context embedded into ctx, contexts of the context package embed their parents.
+func parentContext(ctx context.Context) context.Context {
+	v := reflect.ValueOf(ctx)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	field, ok := v.Type().FieldByName("Context")
+	if !ok || !field.IsExported() || field.Type != reflect.TypeFor[context.Context]() {
+		return nil
+	}
+
+	parent, _ := v.FieldByIndex(field.Index).Interface().(context.Context)
+
+	return parent
+}
@@ -0,0 +1,99 @@
+package assessor_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/xgamtx/go-mockery-descriptor/pkg/assessor"
+)
+
+type ctxKey struct{}
+
+func TestContextMatchers(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	parent := context.WithValue(context.Background(), ctxKey{}, "tenant")
+	withDeadline, cancelDeadline := context.WithTimeout(parent, time.Hour)
+	t.Cleanup(cancelDeadline)
+	cancelled, cancel := context.WithCancel(parent)
+	cancel()
+
+	type testCase struct {
+		name    string
+		matcher assessor.Matcher
+		actual  any
+		wantRes bool
+	}
+	tests := []testCase{
+		{
+			name:    "has deadline",
+			matcher: assessor.ContextHasDeadline(),
+			actual:  withDeadline,
+			wantRes: true,
+		},
+		{
+			name:    "has no deadline",
+			matcher: assessor.ContextHasDeadline(),
+			actual:  parent,
+			wantRes: false,
+		},
+		{
+			name:    "not cancelled",
+			matcher: assessor.ContextNotCancelled(),
+			actual:  withDeadline,
+			wantRes: true,
+		},
+		{
+			name:    "cancelled",
+			matcher: assessor.ContextNotCancelled(),
+			actual:  cancelled,
+			wantRes: false,
+		},
+		{
+			name:    "nil context",
+			matcher: assessor.ContextNotCancelled(),
+			actual:  nil,
+			wantRes: false,
+		},
+		{
+			name:    "value is inherited",
+			matcher: assessor.ContextValue(ctxKey{}, "tenant"),
+			actual:  withDeadline,
+			wantRes: true,
+		},
+		{
+			name:    "different value",
+			matcher: assessor.ContextValue(ctxKey{}, "other"),
+			actual:  withDeadline,
+			wantRes: false,
+		},
+		{
+			name:    "parent itself",
+			matcher: assessor.ContextDerivedFrom(parent),
+			actual:  parent,
+			wantRes: true,
+		},
+		{
+			name:    "derived through timer and value contexts",
+			matcher: assessor.ContextDerivedFrom(parent),
+			actual:  context.WithValue(withDeadline, ctxKey{}, "other"),
+			wantRes: true,
+		},
+		{
+			name:    "not derived",
+			matcher: assessor.ContextDerivedFrom(parent),
+			actual:  context.WithValue(context.Background(), ctxKey{}, "tenant"),
+			wantRes: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.wantRes, tt.matcher.Matches(tt.actual))
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package assessor
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// matcher is a matcher created by this package. It describes its expectation by String and explains mismatches
+// by Explain, so combinators and failures tell what was expected without calling matchers with made up arguments.
+type matcher struct {
+	description string
+	match       func(actual any) (bool, string)
+}
+
+// newMatcher creates a matcher with a description.
+func newMatcher(description string, match func(actual any) bool) Matcher {
+	return newExplainingMatcher(description, func(actual any) (bool, string) { return match(actual), "" })
+}
+
+// newExplainingMatcher creates a matcher with a description, match explains mismatches in details.
+func newExplainingMatcher(description string, match func(actual any) (bool, string)) Matcher {
+	return &matcher{description: description, match: match}
+}
+
+func (m *matcher) Matches(actual any) bool {
+	ok, _ := m.match(actual)
+
+	return ok
+}
+
+func (m *matcher) String() string { return m.description }
+
+// Explain tells why the argument is not matched, it is empty for matched arguments.
+func (m *matcher) Explain(actual any) string {
+	ok, explanation := m.match(actual)
+	if ok {
+		return ""
+	}
+
+	res := fmt.Sprintf("expected %s, got %#v", m.description, actual)
+	if explanation != "" {
+		res += ": " + explanation
+	}
+
+	return res
+}
+
+// Explain tells why matcher does not match the argument, it is empty for matched arguments. Matchers of this package
+// give details like differing fields, other matchers are described by fmt, which calls their String methods.
+// testify prints argument matchers as signatures of their functions, so call Explain to see what went wrong.
+func Explain(matcher Matcher, actual any) string {
+	if m, ok := matcher.(interface{ Explain(actual any) string }); ok {
+		return m.Explain(actual)
+	}
+	if safeMatch(matcher, actual) {
+		return ""
+	}
+
+	return fmt.Sprintf("expected %v, got %#v", matcher, actual)
+}
+
+// Predicate matches arguments of type T accepted by fn, the description is given by String of the matcher.
+func Predicate[T any](description string, fn func(actual T) bool) Matcher {
+	return newMatcher(description, func(actual any) bool {
+		v, ok := as[T](actual)
+
+		return ok && fn(v)
+	})
+}
+
+// as converts the argument to T, nil is converted to the zero value of types that can be nil.
+func as[T any](actual any) (T, bool) {
+	if v, ok := actual.(T); ok {
+		return v, true
+	}
+
+	var zero T
+	if actual != nil {
+		return zero, false
+	}
+
+	switch reflect.TypeFor[T]().Kind() { //nolint:exhaustive
+	case reflect.Interface, reflect.Pointer, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func:
+		return zero, true
+	default:
+		return zero, false
+	}
+}
+
+// operand is an expectation of a combinator: a matcher or a plain value compared by equality.
+type operand struct {
+	description string
+	match       func(actual any) bool
+	// explain explains mismatches of matchers of this package
+	explain func(actual any) string
+}
+
+// explanation tells why the operand does not match the argument.
+func (o operand) explanation(actual any) string {
+	if o.explain != nil {
+		return o.explain(actual)
+	}
+
+	return "expected " + o.description
+}
+
+func newOperand(expected any) operand {
+	if expected == mock.Anything {
+		return operand{description: "anything", match: func(any) bool { return true }}
+	}
+
+	switch m := expected.(type) {
+	case *matcher:
+		return operand{description: m.description, match: m.Matches, explain: m.Explain}
+	case Matcher:
+		// foreign matchers are described by fmt, which never calls them
+		return operand{description: fmt.Sprint(m), match: func(actual any) bool { return safeMatch(m, actual) }}
+	default:
+		return operand{
+			description: fmt.Sprintf("%#v", expected),
+			match:       func(actual any) bool { return reflect.DeepEqual(expected, actual) },
+		}
+	}
+}
+
+func newOperands(expected []any) []operand {
+	res := make([]operand, 0, len(expected))
+	for _, e := range expected {
+		res = append(res, newOperand(e))
+	}
+
+	return res
+}
+
+func describeOperands(operands []operand) string {
+	descriptions := make([]string, 0, len(operands))
+	for _, o := range operands {
+		descriptions = append(descriptions, o.description)
+	}
+
+	return strings.Join(descriptions, ", ")
+}
+
+// safeMatch treats panics of foreign matchers as mismatches.
+func safeMatch(matcher Matcher, actual any) (res bool) { //nolint:nonamedreturns
+	defer func() {
+		if recover() != nil {
+			res = false
+		}
+	}()
+
+	return matcher.Matches(actual)
+}
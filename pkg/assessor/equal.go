@@ -0,0 +1,310 @@
+package assessor
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+)
+
+// Option changes how matchers of this package compare values.
+type Option func(*options)
+
+type options struct {
+	ignoreUnexported bool
+	nilEqualsEmpty   bool
+	equalMethods     bool
+	withTolerance    bool
+	floatTolerance   float64
+	ignoreOrder      bool
+}
+
+// IgnoreUnexported skips unexported fields of structs.
+func IgnoreUnexported() Option {
+	return func(o *options) { o.ignoreUnexported = true }
+}
+
+// NilEqualsEmpty treats nil slices and maps as equal to empty ones.
+func NilEqualsEmpty() Option {
+	return func(o *options) { o.nilEqualsEmpty = true }
+}
+
+// UseEqualMethods compares values of types having an Equal(T) bool method by the method, e.g. time.Time
+// values differing in monotonic clock readings only are equal then.
+func UseEqualMethods() Option {
+	return func(o *options) { o.equalMethods = true }
+}
+
+// FloatTolerance treats floats differing by at most tolerance as equal, NaNs are equal to each other then.
+func FloatTolerance(tolerance float64) Option {
+	return func(o *options) {
+		o.withTolerance = true
+		o.floatTolerance = tolerance
+	}
+}
+
+// IgnoreOrder compares slices and arrays regardless of the order of elements, at any depth.
+func IgnoreOrder() Option {
+	return func(o *options) { o.ignoreOrder = true }
+}
+
+// maxDiffs limits the number of differences explaining a mismatch.
+const maxDiffs = 10
+
+// visit is a pair of references compared already, it breaks cycles.
+type visit struct {
+	x, y uintptr
+	t    reflect.Type
+}
+
+// equality compares values like reflect.DeepEqual does unless options say otherwise. Unlike reflect.DeepEqual
+// it works on values of unexported fields, which can't be converted back to interfaces, and collects differences.
+type equality struct {
+	options
+	visited map[visit]bool
+	diffs   []string
+}
+
+func newEquality(opts []Option) *equality {
+	res := &equality{visited: make(map[visit]bool)}
+	for _, opt := range opts {
+		opt(&res.options)
+	}
+
+	return res
+}
+
+func deepEqual(x, y reflect.Value) bool {
+	return newEquality(nil).equal(x, y, "")
+}
+
+func equalValues(expected, actual any, opts []Option) bool {
+	return newEquality(opts).equal(reflect.ValueOf(expected), reflect.ValueOf(actual), "")
+}
+
+// differ records the difference found at path and reports the values as not equal.
+func (e *equality) differ(path, format string, args ...any) bool {
+	if len(e.diffs) < maxDiffs {
+		if path != "" {
+			format = path + ": " + format
+		}
+		e.diffs = append(e.diffs, fmt.Sprintf(format, args...))
+	}
+
+	return false
+}
+
+func (e *equality) differValues(path string, x, y reflect.Value) bool {
+	return e.differ(path, "expected %#v, got %#v", x, y)
+}
+
+func (e *equality) equal(x, y reflect.Value, path string) bool { //nolint:cyclop,funlen,gocognit
+	if !x.IsValid() || !y.IsValid() {
+		if x.IsValid() == y.IsValid() {
+			return true
+		}
+
+		return e.differ(path, "expected %#v, got %#v", valueOrNil(x), valueOrNil(y))
+	}
+	if x.Type() != y.Type() {
+		return e.differ(path, "expected type %s, got %s", x.Type(), y.Type())
+	}
+	if equal, ok := e.callEqualMethod(x, y); ok {
+		return equal || e.differValues(path, x, y)
+	}
+
+	switch x.Kind() { //nolint:exhaustive
+	case reflect.Bool:
+		return x.Bool() == y.Bool() || e.differValues(path, x, y)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return x.Int() == y.Int() || e.differValues(path, x, y)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return x.Uint() == y.Uint() || e.differValues(path, x, y)
+	case reflect.Float32, reflect.Float64:
+		return e.equalFloats(x.Float(), y.Float()) || e.differValues(path, x, y)
+	case reflect.Complex64, reflect.Complex128:
+		return (e.equalFloats(real(x.Complex()), real(y.Complex())) &&
+			e.equalFloats(imag(x.Complex()), imag(y.Complex()))) || e.differValues(path, x, y)
+	case reflect.String:
+		return x.String() == y.String() || e.differValues(path, x, y)
+	case reflect.Chan, reflect.UnsafePointer:
+		return x.Pointer() == y.Pointer() || e.differ(path, "expected the same %s", x.Type())
+	case reflect.Func:
+		return (x.IsNil() && y.IsNil()) || e.differ(path, "functions are equal only when both are nil")
+	case reflect.Interface:
+		if x.IsNil() || y.IsNil() {
+			return x.IsNil() == y.IsNil() || e.differValues(path, x, y)
+		}
+
+		return e.equal(x.Elem(), y.Elem(), path)
+	case reflect.Pointer:
+		if x.Pointer() == y.Pointer() {
+			return true
+		}
+		if x.IsNil() || y.IsNil() {
+			return e.differValues(path, x, y)
+		}
+		if e.isVisited(x, y) {
+			return true
+		}
+
+		return e.equal(x.Elem(), y.Elem(), path)
+	case reflect.Array:
+		return e.equalElements(x, y, path)
+	case reflect.Slice:
+		if ok, done := e.equalContainers(x, y, path); done {
+			return ok
+		}
+
+		return e.equalElements(x, y, path)
+	case reflect.Map:
+		if ok, done := e.equalContainers(x, y, path); done {
+			return ok
+		}
+
+		return e.equalMaps(x, y, path)
+	case reflect.Struct:
+		res := true
+		for i := range x.NumField() {
+			field := x.Type().Field(i)
+			if e.ignoreUnexported && !field.IsExported() {
+				continue
+			}
+
+			res = e.equal(x.Field(i), y.Field(i), path+"."+field.Name) && res
+		}
+
+		return res
+	default:
+		return e.differValues(path, x, y)
+	}
+}
+
+func valueOrNil(v reflect.Value) any {
+	if !v.IsValid() {
+		return nil
+	}
+
+	return v
+}
+
+// callEqualMethod compares values by their Equal(T) bool method, it is not called on values of unexported fields.
+func (e *equality) callEqualMethod(x, y reflect.Value) (equal, ok bool) { //nolint:nonamedreturns
+	if !e.equalMethods || !x.CanInterface() || !y.CanInterface() {
+		return false, false
+	}
+
+	if !hasEqualMethod(x.Type()) {
+		return false, false
+	}
+
+	return x.MethodByName("Equal").Call([]reflect.Value{y})[0].Bool(), true
+}
+
+// hasEqualMethod tells whether t has an Equal(t) bool method.
+func hasEqualMethod(t reflect.Type) bool {
+	method, ok := t.MethodByName("Equal")
+	if !ok {
+		return false
+	}
+
+	m := method.Type // the receiver is the first argument
+	return m.NumIn() == 2 && m.In(1) == t && m.NumOut() == 1 && m.Out(0).Kind() == reflect.Bool
+}
+
+func (e *equality) equalFloats(x, y float64) bool {
+	if !e.withTolerance {
+		return x == y
+	}
+	if math.IsNaN(x) || math.IsNaN(y) {
+		return math.IsNaN(x) && math.IsNaN(y)
+	}
+
+	return x == y || math.Abs(x-y) <= e.floatTolerance
+}
+
+// equalContainers compares nil-ness and lengths of slices or maps, done is false when elements are to be compared.
+func (e *equality) equalContainers(x, y reflect.Value, path string) (ok, done bool) { //nolint:nonamedreturns
+	if x.IsNil() != y.IsNil() && !(e.nilEqualsEmpty && x.Len() == 0 && y.Len() == 0) {
+		return e.differValues(path, x, y), true
+	}
+	if x.Len() != y.Len() {
+		return e.differ(path, "expected length %d, got %d", x.Len(), y.Len()), true
+	}
+	if x.Len() == 0 || x.Pointer() == y.Pointer() || e.isVisited(x, y) {
+		return true, true
+	}
+
+	return false, false
+}
+
+func (e *equality) equalElements(x, y reflect.Value, path string) bool {
+	if e.ignoreOrder {
+		return e.equalUnordered(x, y, path)
+	}
+
+	res := true
+	for i := range x.Len() {
+		res = e.equal(x.Index(i), y.Index(i), fmt.Sprintf("%s[%d]", path, i)) && res
+	}
+
+	return res
+}
+
+// equalUnordered pairs elements of x with equal elements of y, elements are compared by separate equalities
+// not to report differences of elements that are not paired.
+func (e *equality) equalUnordered(x, y reflect.Value, path string) bool {
+	missing, extra := matchElements(x, y, e.options, func(x, y reflect.Value) bool {
+		return (&equality{options: e.options, visited: make(map[visit]bool)}).equal(x, y, "")
+	})
+	for _, i := range missing {
+		e.differ(fmt.Sprintf("%s[%d]", path, i), "missing %#v", x.Index(i))
+	}
+	for _, j := range extra {
+		e.differ(fmt.Sprintf("%s[%d]", path, j), "unexpected %#v", y.Index(j))
+	}
+
+	return len(missing) == 0 && len(extra) == 0
+}
+
+func (e *equality) equalMaps(x, y reflect.Value, path string) bool {
+	res := true
+	iter := x.MapRange()
+	for iter.Next() {
+		keyPath := fmt.Sprintf("%s[%#v]", path, iter.Key())
+		yValue := y.MapIndex(iter.Key())
+		if !yValue.IsValid() {
+			res = e.differ(keyPath, "missing")
+
+			continue
+		}
+
+		res = e.equal(iter.Value(), yValue, keyPath) && res
+	}
+
+	return res
+}
+
+// isVisited marks the pair of references as visited, comparing it again would loop forever.
+func (e *equality) isVisited(x, y reflect.Value) bool {
+	v := visit{x: x.Pointer(), y: y.Pointer(), t: x.Type()}
+	if e.visited[v] {
+		return true
+	}
+	e.visited[v] = true
+
+	return false
+}
+
+// Equal matches arguments equal to expected, by default values are compared like reflect.DeepEqual does.
+// A mismatch is explained by differences of the values.
+func Equal(expected any, opts ...Option) Matcher {
+	return newExplainingMatcher(fmt.Sprintf("equal to %#v", expected), func(actual any) (bool, string) {
+		e := newEquality(opts)
+		if e.equal(reflect.ValueOf(expected), reflect.ValueOf(actual), "") {
+			return true, ""
+		}
+
+		return false, strings.Join(e.diffs, "; ")
+	})
+}
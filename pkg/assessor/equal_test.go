@@ -0,0 +1,120 @@
+package assessor_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/xgamtx/go-mockery-descriptor/pkg/assessor"
+)
+
+type measurement struct {
+	Value float64
+	At    time.Time
+	Tags  []string
+	cache map[string]int
+}
+
+func TestEqual(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	now := time.Now()
+	expected := measurement{Value: 1.5, At: now.Round(0), Tags: nil, cache: map[string]int{"a": 1}}
+
+	type testCase struct {
+		name    string
+		matcher assessor.Matcher
+		actual  any
+		wantRes bool
+	}
+	tests := []testCase{
+		{
+			name:    "equal",
+			matcher: assessor.Equal(expected),
+			actual:  expected,
+			wantRes: true,
+		},
+		{
+			name:    "unexported field differs",
+			matcher: assessor.Equal(expected),
+			actual:  measurement{Value: 1.5, At: now.Round(0)},
+			wantRes: false,
+		},
+		{
+			name:    "unexported field is ignored",
+			matcher: assessor.Equal(expected, assessor.IgnoreUnexported()),
+			actual:  measurement{Value: 1.5, At: now.Round(0)},
+			wantRes: true,
+		},
+		{
+			name:    "nil and empty slices differ",
+			matcher: assessor.Equal(expected),
+			actual:  measurement{Value: 1.5, At: now.Round(0), Tags: []string{}, cache: map[string]int{"a": 1}},
+			wantRes: false,
+		},
+		{
+			name:    "nil slice equals empty one",
+			matcher: assessor.Equal(expected, assessor.NilEqualsEmpty()),
+			actual:  measurement{Value: 1.5, At: now.Round(0), Tags: []string{}, cache: map[string]int{"a": 1}},
+			wantRes: true,
+		},
+		{
+			name:    "monotonic clock differs",
+			matcher: assessor.Equal(expected),
+			actual:  measurement{Value: 1.5, At: now, cache: map[string]int{"a": 1}},
+			wantRes: false,
+		},
+		{
+			name:    "times are compared by Equal",
+			matcher: assessor.Equal(expected, assessor.UseEqualMethods()),
+			actual:  measurement{Value: 1.5, At: now, cache: map[string]int{"a": 1}},
+			wantRes: true,
+		},
+		{
+			name:    "float within tolerance",
+			matcher: assessor.Equal(expected, assessor.FloatTolerance(0.01)),
+			actual:  measurement{Value: 1.505, At: now.Round(0), cache: map[string]int{"a": 1}},
+			wantRes: true,
+		},
+		{
+			name:    "NaN is not equal to NaN",
+			matcher: assessor.Equal(math.NaN()),
+			actual:  math.NaN(),
+			wantRes: false,
+		},
+		{
+			name:    "NaN equals NaN with tolerance",
+			matcher: assessor.Equal(math.NaN(), assessor.FloatTolerance(0)),
+			actual:  math.NaN(),
+			wantRes: true,
+		},
+		{
+			name:    "elements within tolerance",
+			matcher: assessor.ElementsMatch([]float64{1, 2}, assessor.FloatTolerance(0.1)),
+			actual:  []float64{2.05, 0.95},
+			wantRes: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			args := mock.Arguments{assessor.Arg(tt.matcher)}
+			_, diffs := args.Diff([]any{tt.actual})
+			assert.Equal(t, tt.wantRes, diffs == 0)
+		})
+	}
+}
+
+func TestEqual_diff(t *testing.T) {
+	t.Parallel()
+
+	expected := measurement{Value: 1, Tags: []string{"a", "b"}, cache: map[string]int{"a": 1}}
+	actual := measurement{Value: 2, Tags: []string{"a", "c"}, cache: map[string]int{"b": 1}}
+
+	explanation := assessor.Explain(assessor.Equal(expected), actual)
+	assert.Contains(t, explanation, `.Value: expected 1, got 2; .Tags[1]: expected "b", got "c"; .cache["a"]: missing`)
+}
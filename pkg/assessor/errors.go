@@ -0,0 +1,63 @@
+package assessor
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ErrorIs matches errors having target in their chain as errors.Is tells, a nil target matches nil errors only.
+// Wrapped errors never equal their sentinels, so errors passed to mocks are better matched by it.
+func ErrorIs(target error) Matcher {
+	description := "nil error"
+	if target != nil {
+		description = fmt.Sprintf("error wrapping %q", target.Error())
+	}
+
+	return newExplainingMatcher(description, func(actual any) (bool, string) {
+		err, ok := as[error](actual)
+		if !ok {
+			return false, ""
+		}
+
+		return errors.Is(err, target), explainError(err)
+	})
+}
+
+// ErrorAs matches errors having an error of type T in their chain as errors.As tells.
+func ErrorAs[T error]() Matcher {
+	description := fmt.Sprintf("error of type %s", reflect.TypeFor[T]())
+
+	return newExplainingMatcher(description, func(actual any) (bool, string) {
+		err, ok := as[error](actual)
+		if !ok {
+			return false, ""
+		}
+
+		var target T
+
+		return errors.As(err, &target), explainError(err)
+	})
+}
+
+// ErrorContains matches non-nil errors with messages containing substr.
+func ErrorContains(substr string) Matcher {
+	return newExplainingMatcher(fmt.Sprintf("error containing %q", substr), func(actual any) (bool, string) {
+		err, ok := as[error](actual)
+		if !ok || err == nil {
+			return false, ""
+		}
+
+		return strings.Contains(err.Error(), substr), explainError(err)
+	})
+}
+
+// explainError gives the message of the error, %#v shows wrapped errors as structs only.
+func explainError(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("message %q", err.Error())
+}
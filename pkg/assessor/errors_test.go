@@ -0,0 +1,107 @@
+package assessor_test
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/xgamtx/go-mockery-descriptor/pkg/assessor"
+)
+
+var errNotFound = errors.New("not found")
+
+func TestErrorMatchers(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	type testCase struct {
+		name    string
+		matcher assessor.Matcher
+		actual  any
+		wantRes bool
+	}
+	wrapped := fmt.Errorf("get user: %w", errNotFound)
+	pathErr := fmt.Errorf("read config: %w", &fs.PathError{Op: "open", Path: "config.yaml", Err: fs.ErrNotExist})
+	tests := []testCase{
+		{
+			name:    "is, wrapped",
+			matcher: assessor.ErrorIs(errNotFound),
+			actual:  wrapped,
+			wantRes: true,
+		},
+		{
+			name:    "is, other error",
+			matcher: assessor.ErrorIs(errNotFound),
+			actual:  errors.New("not found"),
+			wantRes: false,
+		},
+		{
+			name:    "is, nil target",
+			matcher: assessor.ErrorIs(nil),
+			actual:  nil,
+			wantRes: true,
+		},
+		{
+			name:    "is, nil target and an error",
+			matcher: assessor.ErrorIs(nil),
+			actual:  wrapped,
+			wantRes: false,
+		},
+		{
+			name:    "as",
+			matcher: assessor.ErrorAs[*fs.PathError](),
+			actual:  pathErr,
+			wantRes: true,
+		},
+		{
+			name:    "as, other type",
+			matcher: assessor.ErrorAs[*fs.PathError](),
+			actual:  wrapped,
+			wantRes: false,
+		},
+		{
+			name:    "as, nil",
+			matcher: assessor.ErrorAs[*fs.PathError](),
+			actual:  nil,
+			wantRes: false,
+		},
+		{
+			name:    "contains",
+			matcher: assessor.ErrorContains("get user"),
+			actual:  wrapped,
+			wantRes: true,
+		},
+		{
+			name:    "contains, nil",
+			matcher: assessor.ErrorContains(""),
+			actual:  nil,
+			wantRes: false,
+		},
+		{
+			name:    "not an error",
+			matcher: assessor.ErrorContains("not found"),
+			actual:  "not found",
+			wantRes: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			args := mock.Arguments{assessor.Arg(tt.matcher)}
+			_, diffs := args.Diff([]any{tt.actual})
+			assert.Equal(t, tt.wantRes, diffs == 0)
+		})
+	}
+}
+
+func TestErrorIs_diff(t *testing.T) {
+	t.Parallel()
+
+	explanation := assessor.Explain(assessor.ErrorIs(errNotFound), errors.New("timeout"))
+	assert.Contains(t, explanation, `expected error wrapping "not found"`)
+	assert.Contains(t, explanation, `message "timeout"`)
+}
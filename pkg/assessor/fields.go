@@ -0,0 +1,161 @@
+package assessor
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// fieldPaths is a tree of dotted paths of fields, a nil subtree marks the selected field.
+type fieldPaths map[string]fieldPaths
+
+// newFieldPaths builds the tree of paths checking them against the type of expected. A path selecting a field
+// selects all its nested fields too.
+func newFieldPaths(expected any, paths []string) fieldPaths {
+	res := make(fieldPaths, len(paths))
+	for _, path := range paths {
+		tree := res
+		names := strings.Split(path, ".")
+		for i, name := range names {
+			subtree, ok := tree[name]
+			switch {
+			case ok && subtree == nil:
+			case i == len(names)-1:
+				tree[name] = nil
+			case !ok:
+				subtree = make(fieldPaths)
+				tree[name] = subtree
+			}
+			if subtree == nil {
+				break
+			}
+			tree = subtree
+		}
+	}
+
+	if missing := res.missing(reflect.TypeOf(expected)); missing != "" {
+		panic(fmt.Sprintf("assessor: %T has no field %s", expected, missing))
+	}
+
+	return res
+}
+
+// missing returns a path missing in t, paths behind interfaces are checked on match only.
+func (p fieldPaths) missing(t reflect.Type) string {
+	for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice || t.Kind() == reflect.Array ||
+		t.Kind() == reflect.Map {
+		t = t.Elem()
+	}
+	if t.Kind() == reflect.Interface {
+		return ""
+	}
+
+	for name, subtree := range p {
+		if t.Kind() != reflect.Struct {
+			return name
+		}
+
+		field, ok := t.FieldByName(name)
+		if !ok {
+			return name
+		}
+		if subtree == nil {
+			continue
+		}
+		if missing := subtree.missing(field.Type); missing != "" {
+			return name + "." + missing
+		}
+	}
+
+	return ""
+}
+
+// fieldsEquality compares values by selected fields only or by all fields except selected ones.
+type fieldsEquality struct {
+	paths  fieldPaths
+	ignore bool
+}
+
+func (f *fieldsEquality) equal(x, y reflect.Value, paths fieldPaths) bool { //nolint:cyclop
+	if !x.IsValid() || !y.IsValid() {
+		return x.IsValid() == y.IsValid()
+	}
+	if x.Type() != y.Type() {
+		return false
+	}
+
+	switch x.Kind() { //nolint:exhaustive
+	case reflect.Pointer, reflect.Interface:
+		if x.IsNil() || y.IsNil() {
+			return x.IsNil() == y.IsNil()
+		}
+
+		return f.equal(x.Elem(), y.Elem(), paths)
+	case reflect.Slice, reflect.Array:
+		if x.Len() != y.Len() {
+			return false
+		}
+		for i := range x.Len() {
+			if !f.equal(x.Index(i), y.Index(i), paths) {
+				return false
+			}
+		}
+
+		return true
+	case reflect.Map:
+		if x.Len() != y.Len() {
+			return false
+		}
+		iter := x.MapRange()
+		for iter.Next() {
+			yValue := y.MapIndex(iter.Key())
+			if !yValue.IsValid() || !f.equal(iter.Value(), yValue, paths) {
+				return false
+			}
+		}
+
+		return true
+	case reflect.Struct:
+		for i := range x.NumField() {
+			subtree, selected := paths[x.Type().Field(i).Name]
+			switch {
+			case selected && subtree != nil:
+				if !f.equal(x.Field(i), y.Field(i), subtree) {
+					return false
+				}
+			case selected == f.ignore:
+			case !deepEqual(x.Field(i), y.Field(i)):
+				return false
+			}
+		}
+
+		return true
+	default:
+		return deepEqual(x, y)
+	}
+}
+
+func newFieldsMatcher(description string, expected any, paths []string, ignore bool) Matcher {
+	f := &fieldsEquality{paths: newFieldPaths(expected, paths), ignore: ignore}
+
+	return newMatcher(description, func(actual any) bool {
+		return f.equal(reflect.ValueOf(expected), reflect.ValueOf(actual), f.paths)
+	})
+}
+
+// FieldsMatch matches arguments equal to expected in the fields given by dotted paths, e.g. Address.City,
+// other fields are not compared. Paths go through pointers, slices, arrays and maps, so Items.Price compares
+// prices of all items. It panics when a path is missing in the type of expected.
+func FieldsMatch(expected any, paths ...string) Matcher {
+	description := fmt.Sprintf("fields %s of %#v", strings.Join(paths, ", "), expected)
+
+	return newFieldsMatcher(description, expected, paths, false)
+}
+
+// IgnoreFields matches arguments equal to expected in all fields except ones given by dotted paths,
+// see FieldsMatch for paths.
+func IgnoreFields(expected any, paths ...string) Matcher {
+	description := fmt.Sprintf("%#v ignoring fields %s", expected, strings.Join(paths, ", "))
+
+	return newFieldsMatcher(description, expected, paths, true)
+}
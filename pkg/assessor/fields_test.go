@@ -0,0 +1,132 @@
+package assessor_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/xgamtx/go-mockery-descriptor/pkg/assessor"
+)
+
+type address struct {
+	City   string
+	Street string
+}
+
+type item struct {
+	Price float64
+	SKU   string
+}
+
+type order struct {
+	ID        int
+	CreatedAt time.Time
+	Address   *address
+	Items     []item
+	Tags      map[string]item
+	note      string
+}
+
+func TestFieldsMatch(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	expected := order{
+		ID:        1,
+		CreatedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Address:   &address{City: "Berlin", Street: "Main"},
+		Items:     []item{{Price: 10, SKU: "a"}},
+		Tags:      map[string]item{"x": {Price: 1, SKU: "b"}},
+		note:      "n",
+	}
+	changed := func(change func(o *order)) order {
+		res := expected
+		res.Address = &address{City: expected.Address.City, Street: expected.Address.Street}
+		res.Items = []item{expected.Items[0]}
+		res.Tags = map[string]item{"x": expected.Tags["x"]}
+		change(&res)
+
+		return res
+	}
+
+	type testCase struct {
+		name    string
+		matcher assessor.Matcher
+		actual  any
+		wantRes bool
+	}
+	tests := []testCase{
+		{
+			name:    "fields match, other fields differ",
+			matcher: assessor.FieldsMatch(expected, "Address.City", "Items.Price"),
+			actual:  changed(func(o *order) { o.ID = 2; o.Address.Street = "Side"; o.Items[0].SKU = "c"; o.note = "m" }),
+			wantRes: true,
+		},
+		{
+			name:    "nested field differs",
+			matcher: assessor.FieldsMatch(expected, "Address.City"),
+			actual:  changed(func(o *order) { o.Address.City = "Paris" }),
+			wantRes: false,
+		},
+		{
+			name:    "field of slice element differs",
+			matcher: assessor.FieldsMatch(expected, "Items.Price"),
+			actual:  changed(func(o *order) { o.Items[0].Price = 11 }),
+			wantRes: false,
+		},
+		{
+			name:    "field of map value differs",
+			matcher: assessor.FieldsMatch(&expected, "Tags.SKU"),
+			actual:  func() *order { o := changed(func(o *order) { o.Tags["x"] = item{SKU: "c"} }); return &o }(),
+			wantRes: false,
+		},
+		{
+			name:    "ignored fields differ",
+			matcher: assessor.IgnoreFields(expected, "ID", "CreatedAt", "Address.Street"),
+			actual:  changed(func(o *order) { o.ID = 2; o.CreatedAt = time.Now(); o.Address.Street = "Side" }),
+			wantRes: true,
+		},
+		{
+			name:    "unexported field differs",
+			matcher: assessor.IgnoreFields(expected, "ID"),
+			actual:  changed(func(o *order) { o.note = "m" }),
+			wantRes: false,
+		},
+		{
+			name:    "ignored whole field and its nested field",
+			matcher: assessor.IgnoreFields(expected, "Address.City", "Address"),
+			actual:  changed(func(o *order) { o.Address = nil }),
+			wantRes: true,
+		},
+		{
+			name:    "nil pointer",
+			matcher: assessor.FieldsMatch(expected, "Address.City"),
+			actual:  changed(func(o *order) { o.Address = nil }),
+			wantRes: false,
+		},
+		{
+			name:    "different type",
+			matcher: assessor.FieldsMatch(expected, "ID"),
+			actual:  &expected,
+			wantRes: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			args := mock.Arguments{assessor.Arg(tt.matcher)}
+			_, diffs := args.Diff([]any{tt.actual})
+			assert.Equal(t, tt.wantRes, diffs == 0)
+		})
+	}
+}
+
+func TestFieldsMatch_unknownField(t *testing.T) {
+	t.Parallel()
+
+	assert.PanicsWithValue(t, "assessor: assessor_test.order has no field Address.Zip", func() {
+		assessor.FieldsMatch(order{}, "Address.Zip")
+	})
+}
@@ -0,0 +1,160 @@
+package assessor
+
+import (
+	"hash/maphash"
+	"math"
+	"reflect"
+)
+
+// maxHashDepth limits how deep values are hashed, it breaks cycles. Values equal in depth have equal hashes
+// when they are cut at the same depth, so cutting them keeps hashes consistent with equality.
+const maxHashDepth = 16
+
+// hasher hashes values consistently with equality with the same options: equal values have equal hashes.
+// Parts of values compared loosely, like floats with a tolerance or types with Equal methods, are not hashed.
+type hasher struct {
+	options
+	seed maphash.Seed
+}
+
+func newHasher(o options) *hasher {
+	return &hasher{options: o, seed: maphash.MakeSeed()}
+}
+
+func (h *hasher) hash(v reflect.Value) uint64 {
+	var res maphash.Hash
+	res.SetSeed(h.seed)
+	h.write(&res, v, 0)
+
+	return res.Sum64()
+}
+
+func (h *hasher) write(res *maphash.Hash, v reflect.Value, depth int) { //nolint:cyclop
+	if !v.IsValid() {
+		res.WriteByte(0)
+
+		return
+	}
+	res.WriteByte(byte(v.Kind()))
+	if depth == maxHashDepth {
+		return
+	}
+	if h.equalMethods && hasEqualMethod(v.Type()) {
+		return
+	}
+
+	switch v.Kind() { //nolint:exhaustive
+	case reflect.Bool:
+		maphash.WriteComparable(res, v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		maphash.WriteComparable(res, v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		maphash.WriteComparable(res, v.Uint())
+	case reflect.Float32, reflect.Float64:
+		h.writeFloat(res, v.Float())
+	case reflect.Complex64, reflect.Complex128:
+		h.writeFloat(res, real(v.Complex()))
+		h.writeFloat(res, imag(v.Complex()))
+	case reflect.String:
+		res.WriteString(v.String())
+	case reflect.Chan, reflect.UnsafePointer:
+		maphash.WriteComparable(res, v.Pointer())
+	case reflect.Func:
+		maphash.WriteComparable(res, v.IsNil())
+	case reflect.Interface, reflect.Pointer:
+		if !v.IsNil() {
+			h.write(res, v.Elem(), depth+1)
+		}
+	case reflect.Array, reflect.Slice:
+		h.writeElements(res, v, depth)
+	case reflect.Map:
+		h.writeMap(res, v, depth)
+	case reflect.Struct:
+		for i := range v.NumField() {
+			if h.ignoreUnexported && !v.Type().Field(i).IsExported() {
+				continue
+			}
+
+			h.write(res, v.Field(i), depth+1)
+		}
+	}
+}
+
+// writeFloat writes floats compared exactly only, zeros are normalized as -0 == 0.
+func (h *hasher) writeFloat(res *maphash.Hash, f float64) {
+	if h.withTolerance {
+		return
+	}
+	if f == 0 {
+		f = 0
+	}
+
+	maphash.WriteComparable(res, math.Float64bits(f))
+}
+
+// writeElements writes the length and elements of slices and arrays, nil slices are not told from empty ones
+// as they may be equal. Unordered elements are combined by a sum of their hashes.
+func (h *hasher) writeElements(res *maphash.Hash, v reflect.Value, depth int) {
+	maphash.WriteComparable(res, v.Len())
+	if !h.ignoreOrder {
+		for i := range v.Len() {
+			h.write(res, v.Index(i), depth+1)
+		}
+
+		return
+	}
+
+	var sum uint64
+	for i := range v.Len() {
+		sum += h.sub(depth, v.Index(i))
+	}
+	maphash.WriteComparable(res, sum)
+}
+
+func (h *hasher) writeMap(res *maphash.Hash, v reflect.Value, depth int) {
+	maphash.WriteComparable(res, v.Len())
+
+	var sum uint64
+	iter := v.MapRange()
+	for iter.Next() {
+		sum += h.sub(depth, iter.Key(), iter.Value())
+	}
+	maphash.WriteComparable(res, sum)
+}
+
+// sub hashes a part of a value separately, to combine it regardless of the order of parts.
+func (h *hasher) sub(depth int, parts ...reflect.Value) uint64 {
+	var res maphash.Hash
+	res.SetSeed(h.seed)
+	for _, part := range parts {
+		h.write(&res, part, depth+1)
+	}
+
+	return res.Sum64()
+}
+
+// isPlain tells whether values of t are equal exactly when they are ==, they are matched by a map then.
+func (o options) isPlain(t reflect.Type) bool {
+	if o.equalMethods && hasEqualMethod(t) {
+		return false
+	}
+
+	switch t.Kind() { //nolint:exhaustive
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	case reflect.Array:
+		return !o.ignoreOrder && o.isPlain(t.Elem())
+	case reflect.Struct:
+		for i := range t.NumField() {
+			if (o.ignoreUnexported && !t.Field(i).IsExported()) || !o.isPlain(t.Field(i).Type) {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return false
+	}
+}
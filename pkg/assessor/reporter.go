@@ -0,0 +1,118 @@
+package assessor
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// TestingT is the part of *testing.T used by mocks generated by mockery.
+type TestingT interface {
+	mock.TestingT
+	Cleanup(cleanup func())
+}
+
+// Reporter passes matchers to testify and explains their mismatches in failures of the mock. testify prints
+// an argument not matched by a matcher as "(int=-1) not matched by func(interface {}) bool", so the mock is created
+// with the reporter instead of the test, and the reporter adds explanations of such arguments to the failures.
+// Helper marks only the function calling it, so testify can't mark itself through the reporter, and failures are
+// printed at lines of testify instead of the mock.
+type Reporter struct {
+	t TestingT
+
+	mu   sync.Mutex
+	args []*reportedArg
+}
+
+// reportedArg keeps the latest mismatch of a matcher passed by the reporter.
+type reportedArg struct {
+	// actual is the argument formatted as testify formats it in diffs
+	actual      string
+	explanation string
+}
+
+// NewReporter creates a reporter failing t.
+func NewReporter(t TestingT) *Reporter {
+	return &Reporter{t: t}
+}
+
+// Arg turns the matcher into an argument of testify expectations like Arg of the package, mismatches of the matcher
+// are explained in failures reported by the reporter.
+func (r *Reporter) Arg(matcher Matcher) any {
+	arg := &reportedArg{}
+	r.mu.Lock()
+	r.args = append(r.args, arg)
+	r.mu.Unlock()
+
+	return mock.MatchedBy(func(actual any) bool {
+		explanation := Explain(matcher, actual)
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		arg.actual, arg.explanation = "", explanation
+		if explanation != "" {
+			arg.actual = fmt.Sprintf("(%[1]T=%[1]v)", actual)
+		}
+
+		return explanation == ""
+	})
+}
+
+// Override returns matcher as an argument of testify expectations when it is set and expected otherwise,
+// like Override of the package.
+func (r *Reporter) Override(matcher Matcher, expected any) any {
+	if isNil(matcher) {
+		return expected
+	}
+
+	return r.Arg(matcher)
+}
+
+// Errorf reports the failure to the test, arguments the failure tells not matched are explained after it.
+func (r *Reporter) Errorf(format string, args ...any) {
+	if h, ok := r.t.(tHelper); ok {
+		h.Helper()
+	}
+	msg := fmt.Sprintf(format, args...)
+
+	r.mu.Lock()
+	var explanations []string
+	for _, arg := range r.args {
+		if arg.explanation != "" && strings.Contains(msg, arg.actual+" not matched by") &&
+			!slices.Contains(explanations, arg.explanation) {
+			explanations = append(explanations, arg.explanation)
+		}
+	}
+	r.mu.Unlock()
+
+	if len(explanations) > 0 {
+		msg += "\nMismatches:\n\t" + strings.Join(explanations, "\n\t")
+	}
+	r.t.Errorf("%s", msg)
+}
+
+func (r *Reporter) Logf(format string, args ...any) {
+	if h, ok := r.t.(tHelper); ok {
+		h.Helper()
+	}
+	r.t.Logf(format, args...)
+}
+
+func (r *Reporter) FailNow() {
+	if h, ok := r.t.(tHelper); ok {
+		h.Helper()
+	}
+	r.t.FailNow()
+}
+
+func (r *Reporter) Cleanup(cleanup func()) {
+	r.t.Cleanup(cleanup)
+}
+
+// tHelper is implemented by *testing.T, the reporter is skipped when the line of a failure is printed.
+type tHelper interface {
+	Helper()
+}
@@ -0,0 +1,131 @@
+package assessor_test
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/xgamtx/go-mockery-descriptor/pkg/assessor"
+)
+
+// fakeT collects failures, FailNow stops the goroutine like the one of *testing.T does.
+type fakeT struct {
+	errors []string
+}
+
+func (f *fakeT) Errorf(format string, args ...any) {
+	f.errors = append(f.errors, fmt.Sprintf(format, args...))
+}
+func (f *fakeT) Logf(string, ...any) {}
+func (f *fakeT) FailNow()            { runtime.Goexit() }
+func (f *fakeT) Cleanup(func())      {}
+
+// call calls the method of the mock in a goroutine, so failures stop the goroutine instead of the test.
+func call(m *mock.Mock, args ...any) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		m.MethodCalled("Save", args...)
+	}()
+	<-done
+}
+
+func TestReporter_Arg(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		expected func(r *assessor.Reporter) []any
+		actual   []any
+
+		wantErrors     int
+		wantMismatches []string
+	}{
+		{
+			name: "matched",
+			expected: func(r *assessor.Reporter) []any {
+				return []any{r.Arg(assessor.ElementsMatch([]string{"a", "b"}))}
+			},
+			actual: []any{[]string{"b", "a"}},
+		},
+		{
+			name: "not matched",
+			expected: func(r *assessor.Reporter) []any {
+				return []any{r.Arg(assessor.ElementsMatch([]string{"a"})), r.Arg(assessor.Between(1, 3))}
+			},
+			actual: []any{[]string{"b"}, -1},
+
+			wantErrors: 1,
+			wantMismatches: []string{
+				`expected elements of []string{"a"} in any order, got []string{"b"}`,
+				"expected between 1 and 3, got -1",
+			},
+		},
+		{
+			name: "matched args are not explained",
+			expected: func(r *assessor.Reporter) []any {
+				return []any{r.Arg(assessor.ElementsMatch([]string{"a"})), 2}
+			},
+			actual: []any{[]string{"a"}, 3},
+
+			wantErrors: 1,
+		},
+		{
+			name: "unset override",
+			expected: func(r *assessor.Reporter) []any {
+				return []any{r.Override(nil, 2)}
+			},
+			actual: []any{3},
+
+			wantErrors: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			fake := &fakeT{}
+			reporter := assessor.NewReporter(fake)
+			m := &mock.Mock{}
+			m.Test(reporter)
+			m.On("Save", tt.expected(reporter)...).Return()
+
+			call(m, tt.actual...)
+
+			assert.Len(t, fake.errors, tt.wantErrors)
+			for _, msg := range fake.errors {
+				if len(tt.wantMismatches) == 0 {
+					assert.NotContains(t, msg, "Mismatches:")
+				}
+				for _, mismatch := range tt.wantMismatches {
+					assert.Contains(t, msg, "\n\t"+mismatch)
+				}
+			}
+		})
+	}
+}
+
+func TestReporter_Arg_latestMismatch(t *testing.T) {
+	t.Parallel()
+
+	fake := &fakeT{}
+	reporter := assessor.NewReporter(fake)
+	m := &mock.Mock{}
+	m.Test(reporter)
+	m.On("Save", reporter.Arg(assessor.OneOf([]int{1, 2}))).Return()
+
+	call(m, 5)
+	call(m, 1)
+	call(m, 7)
+
+	if assert.Len(t, fake.errors, 2) {
+		assert.Contains(t, fake.errors[0], "expected one of []int{1, 2}, got 5")
+		assert.Contains(t, fake.errors[1], "expected one of []int{1, 2}, got 7")
+		assert.NotContains(t, fake.errors[1], "got 5")
+		assert.Equal(t, 1, strings.Count(fake.errors[1], "Mismatches:"))
+	}
+}
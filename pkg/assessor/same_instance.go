@@ -0,0 +1,51 @@
+package assessor
+
+import (
+	"reflect"
+	"sync"
+)
+
+// SameInstance returns a stateful matcher: it remembers the first argument it is called with and then matches
+// only that very instance. Pointers, maps, channels and functions are compared by address, slices by address
+// and length, other values by ==. Create one matcher per mock to check that all calls share a transaction
+// or a handle.
+func SameInstance() Matcher {
+	var (
+		mu       sync.Mutex
+		captured any
+		isSet    bool
+	)
+
+	return newMatcher("the instance of the first call", func(actual any) bool {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if !isSet {
+			captured, isSet = actual, true
+
+			return true
+		}
+
+		return isSameInstance(captured, actual)
+	})
+}
+
+func isSameInstance(expected, actual any) bool {
+	if expected == nil || actual == nil {
+		return expected == actual
+	}
+
+	expectedValue, actualValue := reflect.ValueOf(expected), reflect.ValueOf(actual)
+	if expectedValue.Type() != actualValue.Type() {
+		return false
+	}
+
+	switch expectedValue.Kind() { //nolint:exhaustive
+	case reflect.Pointer, reflect.Map, reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		return expectedValue.Pointer() == actualValue.Pointer()
+	case reflect.Slice:
+		return expectedValue.Pointer() == actualValue.Pointer() && expectedValue.Len() == actualValue.Len()
+	default:
+		return expectedValue.Comparable() && expected == actual
+	}
+}
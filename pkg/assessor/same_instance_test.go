@@ -0,0 +1,69 @@
+package assessor_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/xgamtx/go-mockery-descriptor/pkg/assessor"
+)
+
+type handle struct {
+	id int
+}
+
+func TestSameInstance(t *testing.T) {
+	t.Parallel()
+
+	first, equalCopy := &handle{id: 1}, &handle{id: 1}
+	slice := []int{1, 2, 3}
+
+	tests := []struct {
+		name    string
+		actual  []any
+		wantRes []bool
+	}{
+		{
+			name:    "same pointer",
+			actual:  []any{first, first, first},
+			wantRes: []bool{true, true, true},
+		},
+		{
+			name:    "equal but different pointer",
+			actual:  []any{first, equalCopy},
+			wantRes: []bool{true, false},
+		},
+		{
+			name:    "different type",
+			actual:  []any{first, handle{id: 1}},
+			wantRes: []bool{true, false},
+		},
+		{
+			name:    "same slice, shorter slice",
+			actual:  []any{slice, slice, slice[:2]},
+			wantRes: []bool{true, true, false},
+		},
+		{
+			name:    "values are compared by equality",
+			actual:  []any{handle{id: 1}, handle{id: 1}, handle{id: 2}},
+			wantRes: []bool{true, true, false},
+		},
+		{
+			name:    "nil",
+			actual:  []any{nil, nil, first},
+			wantRes: []bool{true, true, false},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			matcher := assessor.SameInstance()
+			got := make([]bool, 0, len(tt.actual))
+			for _, actual := range tt.actual {
+				got = append(got, matcher.Matches(actual))
+			}
+			assert.Equal(t, tt.wantRes, got)
+		})
+	}
+}
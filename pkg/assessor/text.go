@@ -0,0 +1,180 @@
+package assessor
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// Text is a type of texts the text matchers take, e.g. string, []byte or json.RawMessage.
+type Text interface {
+	~string | ~[]byte
+}
+
+// asText returns the text of arguments of string or byte slice types.
+func asText(actual any) (string, bool) {
+	v := reflect.ValueOf(actual)
+	switch {
+	case !v.IsValid():
+		return "", false
+	case v.Kind() == reflect.String:
+		return v.String(), true
+	case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8:
+		return string(v.Bytes()), true
+	default:
+		return "", false
+	}
+}
+
+func newTextMatcher(description string, match func(actual string) bool) Matcher {
+	return newMatcher(description, func(actual any) bool {
+		text, ok := asText(actual)
+
+		return ok && match(text)
+	})
+}
+
+func newExplainingTextMatcher(description string, match func(actual string) (bool, string)) Matcher {
+	return newExplainingMatcher(description, func(actual any) (bool, string) {
+		text, ok := asText(actual)
+		if !ok {
+			return false, ""
+		}
+
+		return match(text)
+	})
+}
+
+// JSONEq matches texts holding JSON documents equal to the expected one, formatting and order of object keys
+// don't matter. Empty texts are taken as null, so unset fields match unset arguments, and invalid expected
+// documents match nothing.
+func JSONEq[T Text](expected T) Matcher {
+	expectedValue, expectedErr := unmarshalJSON(string(expected))
+
+	return newExplainingTextMatcher(fmt.Sprintf("JSON equal to %s", compactJSON([]byte(expected))), func(actual string) (bool, string) {
+		if expectedErr != nil {
+			return false, fmt.Sprintf("invalid expected JSON: %v", expectedErr)
+		}
+
+		actualValue, err := unmarshalJSON(actual)
+		if err != nil {
+			return false, fmt.Sprintf("invalid JSON: %v", err)
+		}
+
+		return reflect.DeepEqual(expectedValue, actualValue), ""
+	})
+}
+
+func unmarshalJSON(text string) (any, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, nil //nolint:nilnil
+	}
+
+	var res any
+	if err := json.Unmarshal([]byte(text), &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func compactJSON(data []byte) string {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return "null"
+	}
+
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, data); err != nil {
+		return string(data)
+	}
+
+	return buf.String()
+}
+
+// YAMLEq matches texts holding YAML documents equal to the expected one, formatting and order of mapping keys
+// don't matter. Empty texts are null documents, invalid expected documents match nothing.
+func YAMLEq[T Text](expected T) Matcher {
+	var expectedValue any
+	expectedErr := yaml.Unmarshal([]byte(expected), &expectedValue)
+
+	return newExplainingTextMatcher(fmt.Sprintf("YAML equal to %q", string(expected)), func(actual string) (bool, string) {
+		if expectedErr != nil {
+			return false, fmt.Sprintf("invalid expected YAML: %v", expectedErr)
+		}
+
+		var actualValue any
+		if err := yaml.Unmarshal([]byte(actual), &actualValue); err != nil {
+			return false, fmt.Sprintf("invalid YAML: %v", err)
+		}
+
+		return reflect.DeepEqual(expectedValue, actualValue), ""
+	})
+}
+
+// Regexp matches texts containing a match of the pattern, anchor the pattern to match whole texts.
+func Regexp[T Text](pattern T) Matcher {
+	re := regexp.MustCompile(string(pattern))
+
+	return newTextMatcher(fmt.Sprintf("text matching %q", re), re.MatchString)
+}
+
+// HasPrefix matches texts starting with the prefix.
+func HasPrefix[T Text](prefix T) Matcher {
+	return newTextMatcher(fmt.Sprintf("text starting with %q", string(prefix)), func(actual string) bool {
+		return strings.HasPrefix(actual, string(prefix))
+	})
+}
+
+// Contains matches texts containing the substring.
+func Contains[T Text](substring T) Matcher {
+	return newTextMatcher(fmt.Sprintf("text containing %q", string(substring)), func(actual string) bool {
+		return strings.Contains(actual, string(substring))
+	})
+}
+
+var (
+	sqlSpaces      = regexp.MustCompile(`\s+`)            //nolint:gochecknoglobals
+	sqlPunctuation = regexp.MustCompile(`\s*([(),;])\s*`) //nolint:gochecknoglobals
+)
+
+// normalizeSQL collapses whitespace and drops it around parentheses, commas and semicolons, a trailing semicolon
+// is dropped too. String literals and quoted identifiers are kept as is.
+func normalizeSQL(query string) string {
+	var res strings.Builder
+	for query != "" {
+		i := strings.IndexAny(query, `'"`)
+		if i < 0 {
+			i = len(query)
+		}
+		res.WriteString(sqlPunctuation.ReplaceAllString(sqlSpaces.ReplaceAllString(query[:i], " "), "$1"))
+		query = query[i:]
+		if query == "" {
+			break
+		}
+
+		// a doubled quote escaping the quote splits the literal in two, both are kept as is anyway
+		end := strings.IndexByte(query[1:], query[0]) + 2
+		if end == 1 {
+			end = len(query)
+		}
+		res.WriteString(query[:end])
+		query = query[end:]
+	}
+
+	return strings.TrimSuffix(strings.TrimSpace(res.String()), ";")
+}
+
+// SQLEq matches queries equal to the expected one up to whitespace, so queries may be formatted differently.
+// Whitespace inside string literals and quoted identifiers has to be the same.
+func SQLEq[T Text](expected T) Matcher {
+	normalized := normalizeSQL(string(expected))
+
+	return newTextMatcher(fmt.Sprintf("SQL equal to %q", normalized), func(actual string) bool {
+		return normalizeSQL(actual) == normalized
+	})
+}
@@ -0,0 +1,164 @@
+package assessor_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/xgamtx/go-mockery-descriptor/pkg/assessor"
+)
+
+type queryText string
+
+func TestTextMatchers(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	type testCase struct {
+		name    string
+		matcher assessor.Matcher
+		actual  any
+		wantRes bool
+	}
+	tests := []testCase{
+		{
+			name:    "JSON with other formatting and key order",
+			matcher: assessor.JSONEq(`{"a": 1, "b": [true, null]}`),
+			actual:  json.RawMessage(`{"b":[true,null],"a":1}`),
+			wantRes: true,
+		},
+		{
+			name:    "JSON differs",
+			matcher: assessor.JSONEq([]byte(`{"a": 1}`)),
+			actual:  `{"a": 2}`,
+			wantRes: false,
+		},
+		{
+			name:    "invalid JSON",
+			matcher: assessor.JSONEq(`{"a": 1}`),
+			actual:  `{"a": 1`,
+			wantRes: false,
+		},
+		{
+			name:    "empty JSON is null",
+			matcher: assessor.JSONEq(""),
+			actual:  []byte(nil),
+			wantRes: true,
+		},
+		{
+			name:    "empty JSON equals null",
+			matcher: assessor.JSONEq([]byte(nil)),
+			actual:  "null",
+			wantRes: true,
+		},
+		{
+			name:    "empty JSON differs",
+			matcher: assessor.JSONEq(""),
+			actual:  `{}`,
+			wantRes: false,
+		},
+		{
+			name:    "invalid expected JSON",
+			matcher: assessor.JSONEq(`{`),
+			actual:  `{`,
+			wantRes: false,
+		},
+		{
+			name:    "YAML with other formatting and key order",
+			matcher: assessor.YAMLEq("a: 1\nb: [x, y]\n"),
+			actual:  []byte("b:\n  - x\n  - y\na: 1\n"),
+			wantRes: true,
+		},
+		{
+			name:    "YAML differs",
+			matcher: assessor.YAMLEq("a: 1"),
+			actual:  "a: 2",
+			wantRes: false,
+		},
+		{
+			name:    "empty YAML",
+			matcher: assessor.YAMLEq(""),
+			actual:  "",
+			wantRes: true,
+		},
+		{
+			name:    "invalid expected YAML",
+			matcher: assessor.YAMLEq("a: [1"),
+			actual:  "a: [1",
+			wantRes: false,
+		},
+		{
+			name:    "regexp of named string type",
+			matcher: assessor.Regexp(`^SELECT .* FROM users`),
+			actual:  queryText("SELECT id FROM users"),
+			wantRes: true,
+		},
+		{
+			name:    "regexp does not match",
+			matcher: assessor.Regexp(`^SELECT`),
+			actual:  "DELETE FROM users",
+			wantRes: false,
+		},
+		{
+			name:    "has prefix",
+			matcher: assessor.HasPrefix("tmp-"),
+			actual:  []byte("tmp-1"),
+			wantRes: true,
+		},
+		{
+			name:    "contains",
+			matcher: assessor.Contains("users"),
+			actual:  "SELECT id FROM accounts",
+			wantRes: false,
+		},
+		{
+			name:    "not a text",
+			matcher: assessor.Contains("1"),
+			actual:  1,
+			wantRes: false,
+		},
+		{
+			name:    "SQL formatted differently",
+			matcher: assessor.SQLEq("SELECT id, name FROM users WHERE id IN ($1, $2);"),
+			actual:  "\n\tSELECT id ,name\n\tFROM users\n\tWHERE id IN ( $1,$2 )\n",
+			wantRes: true,
+		},
+		{
+			name:    "SQL differs",
+			matcher: assessor.SQLEq("SELECT id FROM users"),
+			actual:  "SELECT name FROM users",
+			wantRes: false,
+		},
+		{
+			name:    "SQL string literals keep whitespace",
+			matcher: assessor.SQLEq("SELECT id FROM users WHERE name = 'a  b' AND \"Full  Name\" = 'it''s '"),
+			actual:  "SELECT id\nFROM users\nWHERE name = 'a  b'\n  AND \"Full  Name\" = 'it''s '",
+			wantRes: true,
+		},
+		{
+			name:    "SQL string literals differ by whitespace",
+			matcher: assessor.SQLEq("SELECT id FROM users WHERE name = 'a  b'"),
+			actual:  "SELECT id FROM users WHERE name = 'a b'",
+			wantRes: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			args := mock.Arguments{assessor.Arg(tt.matcher)}
+			_, diffs := args.Diff([]any{tt.actual})
+			assert.Equal(t, tt.wantRes, diffs == 0)
+		})
+	}
+}
+
+func TestJSONEq_explain(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, `expected JSON equal to {, got "{}": invalid expected JSON: unexpected end of JSON input`,
+		assessor.Explain(assessor.JSONEq(`{`), "{}"))
+	assert.Equal(t, `expected JSON equal to null, got "{": invalid JSON: unexpected end of JSON input`,
+		assessor.Explain(assessor.JSONEq(""), "{"))
+}
@@ -0,0 +1,128 @@
+package assessor
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"time"
+)
+
+// Number is a type of numbers the tolerance matchers take.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// asFloat returns the value of arguments of number types.
+func asFloat(actual any) (float64, bool) {
+	v := reflect.ValueOf(actual)
+	switch v.Kind() { //nolint:exhaustive
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	default:
+		return 0, false
+	}
+}
+
+// InDelta matches numbers differing from expected by at most delta.
+func InDelta[T Number](expected T, delta float64) Matcher {
+	return newMatcher(fmt.Sprintf("%v ± %v", expected, delta), func(actual any) bool {
+		v, ok := asFloat(actual)
+
+		return ok && math.Abs(v-float64(expected)) <= delta
+	})
+}
+
+// InEpsilon matches numbers whose relative error to expected is at most epsilon, only zero matches zero.
+func InEpsilon[T Number](expected T, epsilon float64) Matcher {
+	return newMatcher(fmt.Sprintf("%v within relative error %v", expected, epsilon), func(actual any) bool {
+		v, ok := asFloat(actual)
+		if !ok || math.IsNaN(v) {
+			return false
+		}
+		if expected == 0 {
+			return v == 0
+		}
+
+		return math.Abs(v-float64(expected))/math.Abs(float64(expected)) <= epsilon
+	})
+}
+
+// Between matches values from minimum to maximum inclusive. Numbers of all kinds are compared by their values,
+// so Between(1, 3) matches float64 and uint arguments too, while strings are compared to strings only.
+func Between[T cmp.Ordered](minimum, maximum T) Matcher {
+	lower, upper := reflect.ValueOf(minimum), reflect.ValueOf(maximum)
+
+	return newMatcher(fmt.Sprintf("between %v and %v", minimum, maximum), func(actual any) bool {
+		v := reflect.ValueOf(actual)
+		fromLower, ok := compareOrdered(v, lower)
+		if !ok {
+			return false
+		}
+		toUpper, ok := compareOrdered(v, upper)
+
+		return ok && fromLower >= 0 && toUpper <= 0
+	})
+}
+
+// compareOrdered compares strings to strings and numbers of any kinds exactly, NaNs are not comparable.
+func compareOrdered(x, y reflect.Value) (int, bool) {
+	if x.Kind() == reflect.String && y.Kind() == reflect.String {
+		return cmp.Compare(x.String(), y.String()), true
+	}
+
+	bx, ok := asBigFloat(x)
+	if !ok {
+		return 0, false
+	}
+	by, ok := asBigFloat(y)
+	if !ok {
+		return 0, false
+	}
+
+	return bx.Cmp(by), true
+}
+
+// asBigFloat represents numbers exactly, integers do not fit into float64.
+func asBigFloat(v reflect.Value) (*big.Float, bool) {
+	switch v.Kind() { //nolint:exhaustive
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return new(big.Float).SetInt64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return new(big.Float).SetUint64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		if math.IsNaN(v.Float()) {
+			return nil, false
+		}
+
+		return new(big.Float).SetFloat64(v.Float()), true
+	default:
+		return nil, false
+	}
+}
+
+// WithinDuration matches times differing from expected by at most d.
+func WithinDuration(expected time.Time, d time.Duration) Matcher {
+	return Predicate(fmt.Sprintf("%s ± %s", expected, d), func(actual time.Time) bool {
+		diff := actual.Sub(expected)
+
+		return diff >= -d && diff <= d
+	})
+}
+
+// After matches times after the bound.
+func After(bound time.Time) Matcher {
+	return Predicate(fmt.Sprintf("time after %s", bound), func(actual time.Time) bool { return actual.After(bound) })
+}
+
+// Before matches times before the bound.
+func Before(bound time.Time) Matcher {
+	return Predicate(fmt.Sprintf("time before %s", bound), func(actual time.Time) bool { return actual.Before(bound) })
+}
@@ -0,0 +1,152 @@
+package assessor_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/xgamtx/go-mockery-descriptor/pkg/assessor"
+)
+
+type price float64
+
+func TestToleranceMatchers(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	now := time.Now()
+
+	type testCase struct {
+		name    string
+		matcher assessor.Matcher
+		actual  any
+		wantRes bool
+	}
+	tests := []testCase{
+		{
+			name:    "in delta",
+			matcher: assessor.InDelta(price(9.99), 0.01),
+			actual:  price(10),
+			wantRes: true,
+		},
+		{
+			name:    "out of delta",
+			matcher: assessor.InDelta(10, 0.5),
+			actual:  11,
+			wantRes: false,
+		},
+		{
+			name:    "in delta, not a number",
+			matcher: assessor.InDelta(10, 0.5),
+			actual:  "10",
+			wantRes: false,
+		},
+		{
+			name:    "in epsilon",
+			matcher: assessor.InEpsilon(1000.0, 0.01),
+			actual:  1009.0,
+			wantRes: true,
+		},
+		{
+			name:    "out of epsilon",
+			matcher: assessor.InEpsilon(1000.0, 0.01),
+			actual:  1011.0,
+			wantRes: false,
+		},
+		{
+			name:    "in epsilon of zero",
+			matcher: assessor.InEpsilon(0, 0.01),
+			actual:  0.001,
+			wantRes: false,
+		},
+		{
+			name:    "between, converted type",
+			matcher: assessor.Between(1, 10),
+			actual:  int64(10),
+			wantRes: true,
+		},
+		{
+			name:    "not between",
+			matcher: assessor.Between("a", "c"),
+			actual:  "d",
+			wantRes: false,
+		},
+		{
+			name:    "between, other kind",
+			matcher: assessor.Between(1, 10),
+			actual:  5.0,
+			wantRes: true,
+		},
+		{
+			name:    "between, fraction out of range",
+			matcher: assessor.Between(1, 3),
+			actual:  3.5,
+			wantRes: false,
+		},
+		{
+			name:    "between, unsigned",
+			matcher: assessor.Between(-1, 1),
+			actual:  uint64(1),
+			wantRes: true,
+		},
+		{
+			name:    "between, large unsigned",
+			matcher: assessor.Between(-1, math.MaxInt64),
+			actual:  uint64(math.MaxUint64),
+			wantRes: false,
+		},
+		{
+			name:    "between, NaN",
+			matcher: assessor.Between(math.Inf(-1), math.Inf(1)),
+			actual:  math.NaN(),
+			wantRes: false,
+		},
+		{
+			name:    "between, number and string",
+			matcher: assessor.Between(1, 10),
+			actual:  "5",
+			wantRes: false,
+		},
+		{
+			name:    "within duration",
+			matcher: assessor.WithinDuration(now, time.Second),
+			actual:  now.Add(-time.Second),
+			wantRes: true,
+		},
+		{
+			name:    "out of duration",
+			matcher: assessor.WithinDuration(now, time.Second),
+			actual:  now.Add(2 * time.Second),
+			wantRes: false,
+		},
+		{
+			name:    "after",
+			matcher: assessor.After(now),
+			actual:  now.Add(time.Nanosecond),
+			wantRes: true,
+		},
+		{
+			name:    "not after",
+			matcher: assessor.After(now),
+			actual:  now,
+			wantRes: false,
+		},
+		{
+			name:    "before",
+			matcher: assessor.Before(now),
+			actual:  now.Add(-time.Hour),
+			wantRes: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			args := mock.Arguments{assessor.Arg(tt.matcher)}
+			_, diffs := args.Diff([]any{tt.actual})
+			assert.Equal(t, tt.wantRes, diffs == 0)
+		})
+	}
+}
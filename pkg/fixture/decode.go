@@ -0,0 +1,353 @@
+package fixture
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.yaml.in/yaml/v3"
+)
+
+var (
+	durationType        = reflect.TypeFor[time.Duration]()
+	textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+
+	errUnsupportedFormat = errors.New("unsupported fixture format")
+)
+
+// Option configures decoding of fixtures.
+type Option func(*decoder)
+
+// WithValues registers values which can be referenced by name from interface typed fields,
+// e.g. sentinel errors: WithValues(map[string]any{"ErrNotFound": ErrNotFound}).
+func WithValues(values map[string]any) Option {
+	return func(d *decoder) {
+		for name, value := range values {
+			d.values[name] = value
+		}
+	}
+}
+
+// Load reads a JSON (.json) or YAML (.yaml, .yml) fixture from path into dst.
+//
+// Errors are written as strings: a name registered with WithValues or a message of a new error.
+// time.Time and other encoding.TextUnmarshaler values are strings, time.Duration is a string like "1.5s" or
+// a number of nanoseconds, []byte is base64 and pointers are either null or the value they point to.
+func Load(path string, dst any, opts ...Option) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var src any
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		decoder := json.NewDecoder(bytes.NewReader(content))
+		decoder.UseNumber()
+		err = decoder.Decode(&src)
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(content, &src)
+	default:
+		return fmt.Errorf("%w: %s", errUnsupportedFormat, path)
+	}
+	if err != nil {
+		return err
+	}
+
+	d := &decoder{values: make(map[string]any)}
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return fmt.Errorf("fixture: non-nil pointer expected, got %T", dst)
+	}
+
+	return d.decode(rv.Elem(), src, "$")
+}
+
+type decoder struct {
+	values map[string]any
+}
+
+func (d *decoder) errorf(path string, format string, args ...any) error {
+	return fmt.Errorf("fixture: %s: %s", path, fmt.Sprintf(format, args...))
+}
+
+func (d *decoder) decode(dst reflect.Value, src any, path string) error { //nolint:cyclop
+	if src == nil {
+		dst.SetZero()
+
+		return nil
+	}
+
+	if t, ok := src.(time.Time); ok && dst.Type() == timeType {
+		dst.Set(reflect.ValueOf(t))
+
+		return nil
+	}
+
+	if s, ok := src.(string); ok && dst.Kind() != reflect.Interface && dst.Kind() != reflect.Pointer &&
+		reflect.PointerTo(dst.Type()).Implements(textUnmarshalerType) {
+		if err := dst.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s)); err != nil { //nolint:forcetypeassert
+			return d.errorf(path, "%v", err)
+		}
+
+		return nil
+	}
+
+	switch dst.Kind() { //nolint:exhaustive
+	case reflect.Interface:
+		return d.decodeInterface(dst, src, path)
+	case reflect.Pointer:
+		elem := reflect.New(dst.Type().Elem())
+		if err := d.decode(elem.Elem(), src, path); err != nil {
+			return err
+		}
+		dst.Set(elem)
+
+		return nil
+	case reflect.Struct:
+		return d.decodeStruct(dst, src, path)
+	case reflect.Slice, reflect.Array:
+		return d.decodeElements(dst, src, path)
+	case reflect.Map:
+		return d.decodeMap(dst, src, path)
+	case reflect.Bool:
+		b, ok := src.(bool)
+		if !ok {
+			return d.errorf(path, "bool expected, got %T", src)
+		}
+		dst.SetBool(b)
+
+		return nil
+	case reflect.String:
+		s, ok := src.(string)
+		if !ok {
+			return d.errorf(path, "string expected, got %T", src)
+		}
+		dst.SetString(s)
+
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return d.decodeNumber(dst, src, path)
+	default:
+		return d.errorf(path, "unsupported type %s", dst.Type())
+	}
+}
+
+func (d *decoder) decodeInterface(dst reflect.Value, src any, path string) error {
+	if name, ok := src.(string); ok {
+		if value, ok := d.values[name]; ok && value != nil && reflect.TypeOf(value).AssignableTo(dst.Type()) {
+			dst.Set(reflect.ValueOf(value))
+
+			return nil
+		}
+
+		if dst.Type() == errorType {
+			dst.Set(reflect.ValueOf(errors.New(name)))
+
+			return nil
+		}
+	}
+
+	if dst.NumMethod() != 0 {
+		return d.errorf(path, "can't decode %T into %s, register the value with WithValues", src, dst.Type())
+	}
+
+	dst.Set(reflect.ValueOf(plain(src)))
+
+	return nil
+}
+
+// plain replaces json.Number in decoded values by int64 or float64.
+func plain(src any) any {
+	switch v := src.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+
+		return f
+	case []any:
+		for i := range v {
+			v[i] = plain(v[i])
+		}
+
+		return v
+	case map[string]any:
+		for k := range v {
+			v[k] = plain(v[k])
+		}
+
+		return v
+	default:
+		return src
+	}
+}
+
+func toMap(src any) (map[string]any, bool) {
+	switch v := src.(type) {
+	case map[string]any:
+		return v, true
+	case map[any]any:
+		res := make(map[string]any, len(v))
+		for k, value := range v {
+			res[fmt.Sprint(k)] = value
+		}
+
+		return res, true
+	default:
+		return nil, false
+	}
+}
+
+func (d *decoder) decodeStruct(dst reflect.Value, src any, path string) error {
+	m, ok := toMap(src)
+	if !ok {
+		return d.errorf(path, "object expected, got %T", src)
+	}
+
+	t := dst.Type()
+	for key, value := range m {
+		index := -1
+		for i := range t.NumField() {
+			jsonKey, ok := fieldKey(t.Field(i), "json")
+			yamlKey, _ := fieldKey(t.Field(i), "yaml")
+			if ok && (key == jsonKey || key == yamlKey || strings.EqualFold(key, t.Field(i).Name)) {
+				index = i
+
+				break
+			}
+		}
+		if index < 0 {
+			return d.errorf(path, "unknown field %q in %s", key, t)
+		}
+
+		if err := d.decode(dst.Field(index), value, path+"."+key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *decoder) decodeElements(dst reflect.Value, src any, path string) error {
+	if s, ok := src.(string); ok && dst.Type().Elem().Kind() == reflect.Uint8 && dst.Kind() == reflect.Slice {
+		b, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return d.errorf(path, "%v", err)
+		}
+		dst.SetBytes(b)
+
+		return nil
+	}
+
+	items, ok := src.([]any)
+	if !ok {
+		return d.errorf(path, "array expected, got %T", src)
+	}
+
+	if dst.Kind() == reflect.Array {
+		if len(items) != dst.Len() {
+			return d.errorf(path, "%d items expected, got %d", dst.Len(), len(items))
+		}
+	} else {
+		dst.Set(reflect.MakeSlice(dst.Type(), len(items), len(items)))
+	}
+
+	for i, item := range items {
+		if err := d.decode(dst.Index(i), item, path+"["+strconv.Itoa(i)+"]"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *decoder) decodeMap(dst reflect.Value, src any, path string) error {
+	m, ok := toMap(src)
+	if !ok {
+		return d.errorf(path, "object expected, got %T", src)
+	}
+
+	t := dst.Type()
+	dst.Set(reflect.MakeMapWithSize(t, len(m)))
+	for key, value := range m {
+		k := reflect.New(t.Key()).Elem()
+		var keySrc any = key
+		if k.Kind() != reflect.String && !reflect.PointerTo(t.Key()).Implements(textUnmarshalerType) {
+			keySrc = json.Number(key)
+		}
+		if err := d.decode(k, keySrc, path+"."+key); err != nil {
+			return err
+		}
+
+		v := reflect.New(t.Elem()).Elem()
+		if err := d.decode(v, value, path+"."+key); err != nil {
+			return err
+		}
+		dst.SetMapIndex(k, v)
+	}
+
+	return nil
+}
+
+func (d *decoder) decodeNumber(dst reflect.Value, src any, path string) error { //nolint:cyclop
+	if s, ok := src.(string); ok && dst.Type() == durationType {
+		duration, err := time.ParseDuration(s)
+		if err != nil {
+			return d.errorf(path, "%v", err)
+		}
+		dst.SetInt(int64(duration))
+
+		return nil
+	}
+
+	var number json.Number
+	switch v := src.(type) {
+	case json.Number:
+		number = v
+	case int, int64, uint64, float64:
+		number = json.Number(fmt.Sprint(v))
+	default:
+		return d.errorf(path, "number expected, got %T", src)
+	}
+
+	switch dst.Kind() { //nolint:exhaustive
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(number.String(), 10, 64)
+		if err != nil || dst.OverflowInt(i) {
+			return d.errorf(path, "%s doesn't fit %s", number, dst.Type())
+		}
+		dst.SetInt(i)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(number.String(), 64)
+		if err != nil || (dst.Kind() == reflect.Float32 && math.Abs(f) > math.MaxFloat32) {
+			return d.errorf(path, "%s doesn't fit %s", number, dst.Type())
+		}
+		dst.SetFloat(f)
+	default:
+		u, err := strconv.ParseUint(number.String(), 10, 64)
+		if err != nil || dst.OverflowUint(u) {
+			return d.errorf(path, "%s doesn't fit %s", number, dst.Type())
+		}
+		dst.SetUint(u)
+	}
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package fixture_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xgamtx/go-mockery-descriptor/pkg/fixture"
+)
+
+var errNotFound = errors.New("not found")
+
+type loadedCall struct {
+	ID          id            `json:"id,omitempty"          yaml:"id,omitempty"`
+	Count       *int          `json:"count,omitempty"       yaml:"count,omitempty"`
+	Payload     []byte        `json:"payload,omitempty"     yaml:"payload,omitempty"`
+	Labels      map[int]bool  `json:"labels,omitempty"      yaml:"labels,omitempty"`
+	At          time.Time     `json:"at,omitempty"          yaml:"at,omitempty"`
+	Timeout     time.Duration `json:"timeout,omitempty"     yaml:"timeout,omitempty"`
+	Value       any           `json:"value,omitempty"       yaml:"value,omitempty"`
+	ReceivedErr error         `json:"receivedErr,omitempty" yaml:"receivedErr,omitempty"`
+}
+
+type loadedCalls struct {
+	Get []loadedCall `json:"get,omitempty" yaml:"get,omitempty"`
+}
+
+func TestLoad(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	tests := []struct {
+		name string
+
+		fileName string
+		content  string
+
+		want       loadedCalls
+		wantErrMsg string
+	}{
+		{
+			name:     "json",
+			fileName: "calls.json",
+			content: `{"get": [
+				{"id": "a", "count": 3, "payload": "aGk=", "labels": {"1": true}, "at": "2024-03-01T02:03:04Z",
+					"timeout": "1.5s", "value": 1.5, "receivedErr": "ErrNotFound"},
+				{"timeout": 10, "value": {"k": [1]}, "receivedErr": "boom"}
+			]}`,
+
+			want: loadedCalls{Get: []loadedCall{
+				{
+					ID:          "a",
+					Count:       fixture.Ptr(3),
+					Payload:     []byte("hi"),
+					Labels:      map[int]bool{1: true},
+					At:          time.Date(2024, time.March, 1, 2, 3, 4, 0, time.UTC),
+					Timeout:     1500 * time.Millisecond,
+					Value:       1.5,
+					ReceivedErr: errNotFound,
+				},
+				{
+					Timeout:     10,
+					Value:       map[string]any{"k": []any{int64(1)}},
+					ReceivedErr: errors.New("boom"),
+				},
+			}},
+		},
+		{
+			name:     "yaml",
+			fileName: "calls.yaml",
+			content: `
+get:
+  - id: a
+    count: 3
+    at: 2024-03-01T02:03:04Z
+    receivedErr: ErrNotFound
+`,
+
+			want: loadedCalls{Get: []loadedCall{{
+				ID:          "a",
+				Count:       fixture.Ptr(3),
+				At:          time.Date(2024, time.March, 1, 2, 3, 4, 0, time.UTC),
+				ReceivedErr: errNotFound,
+			}}},
+		},
+		{
+			name:     "unknown field",
+			fileName: "calls.json",
+			content:  `{"get": [{"unknown": 1}]}`,
+
+			wantErrMsg: `fixture: $.get[0]: unknown field "unknown" in fixture_test.loadedCall`,
+		},
+		{
+			name:     "wrong type",
+			fileName: "calls.json",
+			content:  `{"get": [{"count": "3"}]}`,
+
+			wantErrMsg: "fixture: $.get[0].count: number expected, got string",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), tt.fileName)
+			require.NoError(t, os.WriteFile(path, []byte(tt.content), 0o600))
+
+			var got loadedCalls
+			err := fixture.Load(path, &got, fixture.WithValues(map[string]any{"ErrNotFound": errNotFound}))
+			if tt.wantErrMsg != "" {
+				assert.EqualError(t, err, tt.wantErrMsg)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSaveLoad(t *testing.T) {
+	t.Parallel()
+
+	want := loadedCalls{Get: []loadedCall{{
+		ID:          "a",
+		Count:       fixture.Ptr(3),
+		Payload:     []byte{0, 1},
+		Labels:      map[int]bool{2: true},
+		At:          time.Date(2024, time.March, 1, 2, 3, 4, 0, time.UTC),
+		Timeout:     time.Second,
+		ReceivedErr: errNotFound,
+	}}}
+
+	for _, fileName := range []string{"calls.json", "calls.yaml"} {
+		t.Run(fileName, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), fileName)
+			require.NoError(t, fixture.Save(path, want))
+
+			var got loadedCalls
+			require.NoError(t, fixture.Load(path, &got))
+			assert.Equal(t, want.Get[0].Count, got.Get[0].Count)
+			assert.Equal(t, want.Get[0].Payload, got.Get[0].Payload)
+			assert.Equal(t, want.Get[0].Labels, got.Get[0].Labels)
+			assert.True(t, want.Get[0].At.Equal(got.Get[0].At))
+			assert.Equal(t, want.Get[0].Timeout, got.Get[0].Timeout)
+			assert.EqualError(t, got.Get[0].ReceivedErr, errNotFound.Error())
+		})
+	}
+}
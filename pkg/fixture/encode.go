@@ -0,0 +1,190 @@
+package fixture
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+)
+
+var textMarshalerType = reflect.TypeFor[encoding.TextMarshaler]()
+
+// member is a single field of an object, objects keep the order of struct fields.
+type member struct {
+	key   string
+	value any
+}
+
+type object []member
+
+func (o object) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString("{")
+	for i, m := range o {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+
+		key, err := json.Marshal(m.key)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := json.Marshal(m.value)
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(key)
+		buf.WriteString(":")
+		buf.Write(value)
+	}
+	buf.WriteString("}")
+
+	return buf.Bytes(), nil
+}
+
+func (o object) MarshalYAML() (any, error) {
+	node := &yaml.Node{Kind: yaml.MappingNode}
+	for _, m := range o {
+		var value yaml.Node
+		if err := value.Encode(m.value); err != nil {
+			return nil, err
+		}
+
+		node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: m.key}, &value)
+	}
+
+	return node, nil
+}
+
+func marshalYAML(v any) ([]byte, error) {
+	return yaml.Marshal(encode(reflect.ValueOf(v)))
+}
+
+func marshalJSON(v any) ([]byte, error) {
+	content, err := json.MarshalIndent(encode(reflect.ValueOf(v)), "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append(content, '\n'), nil
+}
+
+// encode converts v to a tree of plain values: errors become their messages,
+// text marshalers (e.g. time.Time) become strings and zero struct fields are omitted.
+func encode(v reflect.Value) any { //nolint:cyclop
+	if !v.IsValid() {
+		return nil
+	}
+
+	if v.Type().Implements(textMarshalerType) && (v.Kind() != reflect.Pointer || !v.IsNil()) {
+		if text, err := v.Interface().(encoding.TextMarshaler).MarshalText(); err == nil { //nolint:forcetypeassert
+			return string(text)
+		}
+	}
+
+	switch v.Kind() { //nolint:exhaustive
+	case reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		if err, ok := v.Interface().(error); ok {
+			return err.Error()
+		}
+
+		return encode(v.Elem())
+	case reflect.Pointer:
+		if v.IsNil() {
+			return nil
+		}
+
+		return encode(v.Elem())
+	case reflect.Slice:
+		if v.IsNil() {
+			return nil
+		}
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return base64.StdEncoding.EncodeToString(v.Bytes())
+		}
+
+		return encodeElements(v)
+	case reflect.Array:
+		return encodeElements(v)
+	case reflect.Map:
+		if v.IsNil() {
+			return nil
+		}
+
+		return encodeMap(v)
+	case reflect.Struct:
+		return encodeStruct(v)
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return nil
+	default:
+		return v.Interface()
+	}
+}
+
+func encodeElements(v reflect.Value) []any {
+	res := make([]any, 0, v.Len())
+	for i := range v.Len() {
+		res = append(res, encode(v.Index(i)))
+	}
+
+	return res
+}
+
+func encodeMap(v reflect.Value) object {
+	res := make(object, 0, v.Len())
+	iter := v.MapRange()
+	for iter.Next() {
+		key, ok := encode(iter.Key()).(string)
+		if !ok {
+			key = fmt.Sprint(iter.Key().Interface())
+		}
+		res = append(res, member{key: key, value: encode(iter.Value())})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].key < res[j].key })
+
+	return res
+}
+
+func encodeStruct(v reflect.Value) object {
+	t := v.Type()
+	res := make(object, 0, t.NumField())
+	for i := range t.NumField() {
+		field := t.Field(i)
+		key, ok := fieldKey(field, "json")
+		if !ok || v.Field(i).IsZero() {
+			continue
+		}
+
+		res = append(res, member{key: key, value: encode(v.Field(i))})
+	}
+
+	return res
+}
+
+// fieldKey returns the name of the field in the encoding given by tag, ok is false for skipped fields.
+func fieldKey(field reflect.StructField, tag string) (string, bool) {
+	if !field.IsExported() {
+		return "", false
+	}
+
+	name, _, _ := strings.Cut(field.Tag.Get(tag), ",")
+	switch name {
+	case "-":
+		return "", false
+	case "":
+		return field.Name, true
+	default:
+		return name, true
+	}
+}
@@ -0,0 +1,4 @@
+package fixture
+
+// RecordWithUpdate is Record with the -update-fixtures flag replaced by update.
+var RecordWithUpdate = record
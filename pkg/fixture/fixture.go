@@ -0,0 +1,70 @@
+// Package fixture stores descriptor values recorded by generated recorders and loads them back in tests.
+package fixture
+
+import (
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool( //nolint:gochecknoglobals
+	"update-fixtures", false, "rewrite fixtures written by go-mockery-descriptor recorders",
+)
+
+// Ptr returns a pointer to v. It is used by Literal for pointers to values which can't be addressed directly.
+func Ptr[T any](v T) *T { return &v }
+
+// Save writes v to path. Files with the .json, .yaml or .yml extension get a fixture readable by Load,
+// any other file gets a Go literal of v.
+func Save(path string, v any) error {
+	var (
+		content []byte
+		err     error
+	)
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		content, err = marshalJSON(v)
+	case ".yaml", ".yml":
+		content, err = marshalYAML(v)
+	default:
+		content = []byte(Literal(v) + "\n")
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil { //nolint:mnd
+		return err
+	}
+
+	return os.WriteFile(path, content, 0o600) //nolint:mnd
+}
+
+// Record saves the value returned by calls to path once the test is finished.
+// Existing files are only rewritten when the -update-fixtures flag is set.
+func Record(t *testing.T, path string, calls func() any) {
+	t.Helper()
+	record(t, path, *update, calls)
+}
+
+func record(t *testing.T, path string, update bool, calls func() any) {
+	t.Helper()
+	t.Cleanup(func() {
+		if _, err := os.Stat(path); !update && !errors.Is(err, os.ErrNotExist) {
+			return
+		}
+
+		if t.Failed() {
+			t.Logf("fixture %s is not recorded: test failed", path)
+
+			return
+		}
+
+		if err := Save(path, calls()); err != nil {
+			t.Errorf("failed to record fixture %s: %v", path, err)
+		}
+	})
+}
@@ -0,0 +1,136 @@
+package fixture_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xgamtx/go-mockery-descriptor/pkg/fixture"
+)
+
+func TestSave(t *testing.T) {
+	t.Parallel()
+
+	value := []item{{
+		ID:  "x",
+		At:  time.Date(2024, time.March, 1, 2, 3, 4, 0, time.UTC),
+		Err: errors.New("boom"),
+	}}
+
+	tests := []struct {
+		name string
+
+		fileName string
+
+		want string
+	}{
+		{
+			name:     "json",
+			fileName: "calls.json",
+
+			want: `[
+  {
+    "ID": "x",
+    "At": "2024-03-01T02:03:04Z",
+    "Err": "boom"
+  }
+]
+`,
+		},
+		{
+			name:     "literal",
+			fileName: "calls.txt",
+
+			want: `[]item{
+	{
+		ID:  "x",
+		At:  time.Date(2024, time.March, 1, 2, 3, 4, 0, time.UTC),
+		Err: errors.New("boom"),
+	},
+}
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), tt.fileName)
+			require.NoError(t, fixture.Save(path, value))
+
+			got, err := os.ReadFile(path)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestRecord(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+
+		existing *string
+		update   bool
+
+		want string
+	}{
+		{
+			name: "new file",
+
+			want: `"recorded"` + "\n",
+		},
+		{
+			name: "new file, update",
+
+			update: true,
+
+			want: `"recorded"` + "\n",
+		},
+		{
+			name: "existing file is kept",
+
+			existing: fixture.Ptr(`"existing"` + "\n"),
+
+			want: `"existing"` + "\n",
+		},
+		{
+			name: "existing file is rewritten on update",
+
+			existing: fixture.Ptr(`"existing"` + "\n"),
+			update:   true,
+
+			want: `"recorded"` + "\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), "fixtures", "calls.json")
+			if tt.existing != nil {
+				require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750))
+				require.NoError(t, os.WriteFile(path, []byte(*tt.existing), 0o600))
+			}
+
+			value := "pending"
+			t.Run("test", func(t *testing.T) {
+				fixture.RecordWithUpdate(t, path, tt.update, func() any { return value })
+				value = "recorded"
+
+				_, err := os.Stat(path)
+				assert.Equal(t, tt.existing == nil, errors.Is(err, os.ErrNotExist), "written on cleanup only")
+			})
+
+			got, err := os.ReadFile(path)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, string(got))
+		})
+	}
+}
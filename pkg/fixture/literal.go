@@ -0,0 +1,358 @@
+package fixture
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const literalPrefix = "var _ = "
+
+var (
+	timeType  = reflect.TypeFor[time.Time]()
+	errorType = reflect.TypeFor[error]()
+)
+
+// Literal returns v formatted as a Go expression which can be pasted into the package v is declared in.
+// Types of other packages are qualified with their package names, errors are written as errors.New calls.
+// Unexported fields are written for types of the package of v, except fields holding interfaces or times.
+func Literal(v any) string {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() {
+		return "nil"
+	}
+
+	p := &literalPrinter{pkgPath: pkgPathOf(rv.Type())}
+	p.write(rv, nil, false)
+
+	formatted, err := format.Source([]byte(literalPrefix + p.buf.String()))
+	if err != nil {
+		return p.buf.String()
+	}
+
+	return strings.TrimPrefix(string(formatted), literalPrefix)
+}
+
+// pkgPathOf returns the package of the first named type met in t.
+func pkgPathOf(t reflect.Type) string {
+	if t.Name() != "" {
+		return t.PkgPath()
+	}
+
+	switch t.Kind() { //nolint:exhaustive
+	case reflect.Pointer, reflect.Slice, reflect.Array:
+		return pkgPathOf(t.Elem())
+	case reflect.Map:
+		if path := pkgPathOf(t.Key()); path != "" {
+			return path
+		}
+
+		return pkgPathOf(t.Elem())
+	default:
+		return ""
+	}
+}
+
+type literalPrinter struct {
+	pkgPath string
+	buf     bytes.Buffer
+}
+
+func (p *literalPrinter) typeName(t reflect.Type) string {
+	if t == reflect.TypeFor[byte]() {
+		return "byte"
+	}
+
+	if t.Name() != "" {
+		if t.PkgPath() == "" || t.PkgPath() == p.pkgPath {
+			return t.Name()
+		}
+
+		return t.String()
+	}
+
+	switch t.Kind() { //nolint:exhaustive
+	case reflect.Pointer:
+		return "*" + p.typeName(t.Elem())
+	case reflect.Slice:
+		return "[]" + p.typeName(t.Elem())
+	case reflect.Array:
+		return "[" + strconv.Itoa(t.Len()) + "]" + p.typeName(t.Elem())
+	case reflect.Map:
+		return "map[" + p.typeName(t.Key()) + "]" + p.typeName(t.Elem())
+	case reflect.Interface:
+		if t.NumMethod() == 0 {
+			return "any"
+		}
+
+		return t.String()
+	default:
+		return t.String()
+	}
+}
+
+// write prints v, static is the type of the place the value is written to or nil when it is unknown.
+// Type of a composite literal is omitted when elide is set.
+func (p *literalPrinter) write(v reflect.Value, static reflect.Type, elide bool) { //nolint:cyclop
+	if v.Kind() == reflect.Interface {
+		p.writeInterface(v)
+
+		return
+	}
+
+	switch v.Kind() { //nolint:exhaustive
+	case reflect.Bool:
+		p.writeBasic(v, strconv.FormatBool(v.Bool()), static, reflect.Bool)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		p.writeBasic(v, strconv.FormatInt(v.Int(), 10), static, reflect.Int)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		p.writeBasic(v, strconv.FormatUint(v.Uint(), 10), static, reflect.Invalid)
+	case reflect.Float32, reflect.Float64:
+		p.writeBasic(v, formatFloat(v.Float()), static, reflect.Float64)
+	case reflect.Complex64, reflect.Complex128:
+		p.writeBasic(v, strings.Trim(strconv.FormatComplex(v.Complex(), 'g', -1, 128), "()"), static, reflect.Invalid)
+	case reflect.String:
+		p.writeBasic(v, strconv.Quote(v.String()), static, reflect.String)
+	case reflect.Pointer:
+		p.writePointer(v, static)
+	case reflect.Slice:
+		p.writeSlice(v, static, elide)
+	case reflect.Array:
+		p.writeElements(v, elide)
+	case reflect.Map:
+		p.writeMap(v, static, elide)
+	case reflect.Struct:
+		p.writeStruct(v, elide)
+	default:
+		p.writeNil(v.Type(), static)
+	}
+}
+
+func formatFloat(f float64) string {
+	switch {
+	case math.IsNaN(f):
+		return "math.NaN()"
+	case math.IsInf(f, 1):
+		return "math.Inf(1)"
+	case math.IsInf(f, -1):
+		return "math.Inf(-1)"
+	}
+
+	res := strconv.FormatFloat(f, 'g', -1, 64)
+	if !strings.ContainsAny(res, ".e") {
+		res += ".0"
+	}
+
+	return res
+}
+
+// writeBasic prints a constant, converting it explicitly when the constant would get another type otherwise.
+func (p *literalPrinter) writeBasic(v reflect.Value, lit string, static reflect.Type, defaultKind reflect.Kind) {
+	t := v.Type()
+	if t == static || (t.Name() == defaultKind.String() && t.PkgPath() == "") {
+		p.buf.WriteString(lit)
+
+		return
+	}
+
+	p.buf.WriteString(p.typeName(t) + "(" + lit + ")")
+}
+
+func (p *literalPrinter) writeNil(t, static reflect.Type) {
+	if t == static {
+		p.buf.WriteString("nil")
+
+		return
+	}
+
+	p.buf.WriteString("(" + p.typeName(t) + ")(nil)")
+}
+
+func (p *literalPrinter) writeInterface(v reflect.Value) {
+	if v.IsNil() {
+		p.buf.WriteString("nil")
+
+		return
+	}
+
+	if v.Type().Implements(errorType) {
+		if err, ok := v.Interface().(error); ok {
+			p.buf.WriteString("errors.New(" + strconv.Quote(err.Error()) + ")")
+
+			return
+		}
+	}
+
+	p.write(v.Elem(), nil, false)
+}
+
+func (p *literalPrinter) writePointer(v reflect.Value, static reflect.Type) {
+	if v.IsNil() {
+		p.writeNil(v.Type(), static)
+
+		return
+	}
+
+	elem := v.Elem()
+	switch elem.Kind() { //nolint:exhaustive
+	case reflect.Struct, reflect.Slice, reflect.Array, reflect.Map:
+		if elem.Type() != timeType {
+			p.buf.WriteString("&")
+			p.write(elem, nil, false)
+
+			return
+		}
+	}
+
+	p.buf.WriteString("fixture.Ptr[" + p.typeName(elem.Type()) + "](")
+	p.write(elem, elem.Type(), false)
+	p.buf.WriteString(")")
+}
+
+func (p *literalPrinter) writeTypePrefix(t reflect.Type, elide bool) {
+	if !elide {
+		p.buf.WriteString(p.typeName(t))
+	}
+}
+
+func (p *literalPrinter) writeSlice(v reflect.Value, static reflect.Type, elide bool) {
+	if v.IsNil() {
+		p.writeNil(v.Type(), static)
+
+		return
+	}
+
+	if v.Type().Elem().Kind() == reflect.Uint8 && utf8.Valid(v.Bytes()) {
+		p.buf.WriteString(p.typeName(v.Type()) + "(" + strconv.Quote(string(v.Bytes())) + ")")
+
+		return
+	}
+
+	p.writeElements(v, elide)
+}
+
+func (p *literalPrinter) writeElements(v reflect.Value, elide bool) {
+	p.writeTypePrefix(v.Type(), elide)
+	p.buf.WriteString("{")
+	if v.Len() > 0 {
+		p.buf.WriteString("\n")
+	}
+	for i := range v.Len() {
+		p.write(v.Index(i), v.Type().Elem(), true)
+		p.buf.WriteString(",\n")
+	}
+	p.buf.WriteString("}")
+}
+
+func (p *literalPrinter) writeMap(v reflect.Value, static reflect.Type, elide bool) {
+	if v.IsNil() {
+		p.writeNil(v.Type(), static)
+
+		return
+	}
+
+	type entry struct{ key, value string }
+	entries := make([]entry, 0, v.Len())
+	iter := v.MapRange()
+	for iter.Next() {
+		keyPrinter := &literalPrinter{pkgPath: p.pkgPath}
+		keyPrinter.write(iter.Key(), v.Type().Key(), true)
+		valuePrinter := &literalPrinter{pkgPath: p.pkgPath}
+		valuePrinter.write(iter.Value(), v.Type().Elem(), true)
+		entries = append(entries, entry{key: keyPrinter.buf.String(), value: valuePrinter.buf.String()})
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].key < entries[j].key })
+
+	p.writeTypePrefix(v.Type(), elide)
+	p.buf.WriteString("{")
+	if len(entries) > 0 {
+		p.buf.WriteString("\n")
+	}
+	for _, e := range entries {
+		p.buf.WriteString(e.key + ": " + e.value + ",\n")
+	}
+	p.buf.WriteString("}")
+}
+
+func (p *literalPrinter) writeStruct(v reflect.Value, elide bool) {
+	t := v.Type()
+	if t == timeType {
+		p.writeTime(v.Interface().(time.Time)) //nolint:forcetypeassert
+
+		return
+	}
+
+	p.writeTypePrefix(t, elide)
+	p.buf.WriteString("{")
+	var written bool
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if v.Field(i).IsZero() || (!field.IsExported() && (t.PkgPath() != p.pkgPath || holdsOpaque(field.Type, nil))) {
+			continue
+		}
+
+		if !written {
+			p.buf.WriteString("\n")
+			written = true
+		}
+		p.buf.WriteString(field.Name + ": ")
+		p.write(v.Field(i), field.Type, false)
+		p.buf.WriteString(",\n")
+	}
+	p.buf.WriteString("}")
+}
+
+// holdsOpaque reports whether values of t may hold interfaces or times. They are printed by their methods, which
+// reflection can't call on values of unexported fields, so such fields are skipped.
+func holdsOpaque(t reflect.Type, seen map[reflect.Type]bool) bool {
+	if t == timeType || t.Kind() == reflect.Interface {
+		return true
+	}
+	if seen[t] {
+		return false
+	}
+	if seen == nil {
+		seen = make(map[reflect.Type]bool)
+	}
+	seen[t] = true
+
+	switch t.Kind() { //nolint:exhaustive
+	case reflect.Pointer, reflect.Slice, reflect.Array:
+		return holdsOpaque(t.Elem(), seen)
+	case reflect.Map:
+		return holdsOpaque(t.Key(), seen) || holdsOpaque(t.Elem(), seen)
+	case reflect.Struct:
+		for i := range t.NumField() {
+			if holdsOpaque(t.Field(i).Type, seen) {
+				return true
+			}
+		}
+
+		return false
+	default:
+		return false
+	}
+}
+
+func (p *literalPrinter) writeTime(t time.Time) {
+	var loc string
+	switch t.Location() {
+	case time.UTC:
+		loc = "time.UTC"
+	case time.Local:
+		loc = "time.Local"
+	default:
+		name, offset := t.Zone()
+		loc = fmt.Sprintf("time.FixedZone(%q, %d)", name, offset)
+	}
+
+	fmt.Fprintf(&p.buf, "time.Date(%d, time.%s, %d, %d, %d, %d, %d, %s)",
+		t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+}
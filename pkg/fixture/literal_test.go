@@ -0,0 +1,126 @@
+package fixture_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/xgamtx/go-mockery-descriptor/pkg/fixture"
+)
+
+type id string
+
+type item struct {
+	ID      id
+	Count   *int
+	Tags    []string
+	At      time.Time
+	Value   any
+	Err     error
+	Timeout time.Duration
+}
+
+type row struct {
+	name  string
+	at    time.Time
+	err   error
+	inner *row
+}
+
+func TestLiteral(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+
+		value any
+
+		want string
+	}{
+		{
+			name: "nil",
+
+			want: "nil",
+		},
+		{
+			name:  "string",
+			value: "a\"b",
+
+			want: `"a\"b"`,
+		},
+		{
+			name:  "named type",
+			value: id("x"),
+
+			want: `id("x")`,
+		},
+		{
+			name: "struct",
+			value: item{
+				ID:      "x",
+				Count:   fixture.Ptr(2),
+				Tags:    []string{"a"},
+				At:      time.Date(2024, time.March, 1, 2, 3, 4, 5, time.UTC),
+				Value:   int64(7),
+				Err:     errors.New("boom"),
+				Timeout: time.Second,
+			},
+
+			want: `item{
+	ID:    "x",
+	Count: fixture.Ptr[int](2),
+	Tags: []string{
+		"a",
+	},
+	At:      time.Date(2024, time.March, 1, 2, 3, 4, 5, time.UTC),
+	Value:   int64(7),
+	Err:     errors.New("boom"),
+	Timeout: 1000000000,
+}`,
+		},
+		{
+			name:  "slice of structs",
+			value: []item{{ID: "x"}, {}},
+
+			want: `[]item{
+	{
+		ID: "x",
+	},
+	{},
+}`,
+		},
+		{
+			name: "unexported fields",
+			value: []row{{
+				name:  "x",
+				at:    time.Now(),
+				err:   errors.New("boom"),
+				inner: &row{name: "y"},
+			}},
+
+			want: `[]row{
+	{
+		name: "x",
+	},
+}`,
+		},
+		{
+			name:  "map",
+			value: map[string][]byte{"b": []byte("2"), "a": nil},
+
+			want: `map[string][]byte{
+	"a": nil,
+	"b": []byte("2"),
+}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, fixture.Literal(tt.value))
+		})
+	}
+}